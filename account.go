@@ -0,0 +1,75 @@
+package mercadopago
+
+import (
+	"io"
+	"time"
+)
+
+// AccountMovementsPageSize é a quantidade de movimentações buscadas por página pelo AccountMovementsIterator quando o limit não é informado.
+const AccountMovementsPageSize = 50
+
+// DateRange é o método que retorna uma cópia dos filtros com o período de criação das movimentações (range=date_created, begin_date e end_date).
+func (searchParams AccountMovementsSearchParams) DateRange(beginDate, endDate time.Time) AccountMovementsSearchParams {
+	params := AccountMovementsSearchParams{}
+	for key, value := range searchParams {
+		params[key] = value
+	}
+	params["range"] = "date_created"
+	params["begin_date"] = beginDate.In(DefaultTimeZone).Format(TimeLayout)
+	params["end_date"] = endDate.In(DefaultTimeZone).Format(TimeLayout)
+	return params
+}
+
+// AccountMovementsIterator é a struct que percorre todas as movimentações da busca, buscando as próximas páginas conforme o método Next é chamado.
+type AccountMovementsIterator struct {
+	searchParams           AccountMovementsSearchParams
+	mercadoPagoAccessToken []string
+	movements              []AccountMovement
+	offset                 int
+	total                  int
+	done                   bool
+}
+
+// NewAccountMovementsIterator é a função que cria um iterador das movimentações da conta com os filtros informados.
+// Nenhuma requisição é feita até a primeira chamada do método Next.
+func NewAccountMovementsIterator(searchParams AccountMovementsSearchParams, mercadoPagoAccessToken ...string) *AccountMovementsIterator {
+	params := AccountMovementsSearchParams{"limit": AccountMovementsPageSize}
+	for key, value := range searchParams {
+		params[key] = value
+	}
+	return &AccountMovementsIterator{searchParams: params, mercadoPagoAccessToken: mercadoPagoAccessToken}
+}
+
+// Next é o método que retorna a próxima movimentação, buscando a próxima página quando necessário, ou io.EOF quando não existirem mais movimentações.
+// Em caso de erro a mesma página é buscada novamente na próxima chamada.
+func (iterator *AccountMovementsIterator) Next() (*AccountMovement, *ErrorResponse, error) {
+	if len(iterator.movements) == 0 {
+		if iterator.done {
+			return nil, nil, io.EOF
+		}
+
+		iterator.searchParams["offset"] = iterator.offset
+		response, errorResponse, err := SearchAccountMovements(iterator.searchParams, iterator.mercadoPagoAccessToken...)
+		if err != nil || errorResponse != nil {
+			return nil, errorResponse, err
+		}
+
+		iterator.movements = response.Results
+		iterator.offset += len(response.Results)
+		iterator.total = response.Paging.Total
+		iterator.done = len(response.Results) == 0 || iterator.offset >= response.Paging.Total
+
+		if len(iterator.movements) == 0 {
+			return nil, nil, io.EOF
+		}
+	}
+
+	movement := iterator.movements[0]
+	iterator.movements = iterator.movements[1:]
+	return &movement, nil, nil
+}
+
+// Total é o método que retorna o total de movimentações encontradas na busca, disponível após a primeira chamada do método Next.
+func (iterator *AccountMovementsIterator) Total() int {
+	return iterator.total
+}
@@ -0,0 +1,85 @@
+package mercadopago
+
+import (
+	"io"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/eduardo-mior/mercadopago-sdk-go/mercadopagotest"
+)
+
+// Testando a consulta do saldo e a busca das movimentações da conta em um servidor de testes separado, assim os valores não dependem dos outros testes
+func TestSuccessOnAccountBalanceAndMovements(t *testing.T) {
+
+	server := mercadopagotest.NewServer()
+	defer server.Close()
+	defer server.Setenv()()
+
+	userID := strconv.FormatInt(server.CollectorID, 10)
+
+	server.AddPayment(map[string]interface{}{
+		"transaction_amount": 100,
+		"fee_details":        []map[string]interface{}{{"type": "mercadopago_fee", "amount": 4.99, "fee_payer": "collector"}},
+	})
+	server.AddPayment(map[string]interface{}{"transaction_amount": 50, "status": "in_mediation", "status_detail": "in_process"})
+	server.AddPayment(map[string]interface{}{"transaction_amount": 20, "transaction_amount_refunded": 20, "status": "refunded", "status_detail": "refunded"})
+	server.AddPayment(map[string]interface{}{"transaction_amount": 30, "status": "pending", "status_detail": "pending_waiting_transfer"})
+
+	balance, mercadopagoErr, err := GetAccountBalance(userID)
+	if err != nil || mercadopagoErr != nil {
+		t.Fatal("Erro ao consultar o saldo da conta!")
+	}
+	if !balance.AvailableBalance.Equal(MustParseMoney("95.01")) || !balance.UnavailableBalance.Equal(NewMoney(50)) || !balance.TotalAmount.Equal(MustParseMoney("145.01")) || balance.CurrencyID != "BRL" {
+		t.Error("Saldo da conta retornado incorretamente!")
+		t.Error(balance.AvailableBalance, balance.UnavailableBalance, balance.TotalAmount)
+	}
+
+	// Buscando de 2 em 2 para percorrer várias páginas
+	iterator := NewAccountMovementsIterator(AccountMovementsSearchParams{"user_id": userID, "limit": 2}.DateRange(time.Now().Add(-time.Hour), time.Now().Add(time.Hour)))
+
+	var movements []AccountMovement
+	for {
+		movement, mercadopagoErr, err := iterator.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil || mercadopagoErr != nil {
+			t.Fatal("Erro ao buscar as movimentações da conta!")
+		}
+		movements = append(movements, *movement)
+	}
+
+	sum := Money{}
+	for _, movement := range movements {
+		sum = sum.Add(movement.Amount)
+	}
+	if len(movements) != 5 || iterator.Total() != 5 || !sum.Equal(MustParseMoney("145.01")) || movements[1].Detail != "fee" || movements[1].Type != "expense" {
+		t.Error("Movimentações da conta retornadas incorretamente!")
+	}
+
+	if _, _, err := iterator.Next(); err != io.EOF {
+		t.Error("Final das movimentações tratado incorretamente!")
+	}
+
+}
+
+// Testando a busca das movimentações de um período sem movimentações
+func TestAccountMovementsDateRange(t *testing.T) {
+
+	response, mercadopagoErr, err := SearchAccountMovements(AccountMovementsSearchParams{"user_id": testServer.CollectorID}.DateRange(time.Now().AddDate(-1, 0, -1), time.Now().AddDate(-1, 0, 0)))
+	if err != nil || mercadopagoErr != nil || response.Paging.Total != 0 || len(response.Results) != 0 {
+		t.Error("Movimentações filtradas incorretamente pelo período!")
+	}
+
+}
+
+// Testando a consulta do saldo de outra conta
+func TestErrorOnGetAccountBalance(t *testing.T) {
+
+	balance, mercadopagoErr, err := GetAccountBalance("1")
+	if err != nil || balance != nil || mercadopagoErr == nil || mercadopagoErr.Status != 403 {
+		t.Error("Erro da consulta do saldo tratado incorretamente!")
+	}
+
+}
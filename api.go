@@ -1,22 +1,34 @@
 package mercadopago
 
 import (
+	"context"
+	"crypto/rand"
 	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/eduardo-mior/mercadopago-sdk-go/internal/request"
 )
 
+// BASEURL é a URL padrão da API do MercadoPago. Ela pode ser substituida pela variavel de ambiente MERCADO_PAGO_BASE_URL (exemplo: servidor de testes do pacote mercadopagotest).
 const BASEURL = "https://api.mercadopago.com"
 
 // CreatePayment é o método responsável por criar um pagamento no MercadoPago.
+// Os itens sem moeda são enviados com a moeda do site do pagamento (SiteID ou o site configurado no CurrentSite), quando nenhum site é configurado
+// a moeda não é preenchida e o MercadoPago usa a moeda da conta.
 func CreatePayment(paymentRequest PaymentRequest, mercadoPagoAccessToken ...string) (*PaymentResponse, *ErrorResponse, error) {
 
+	paymentRequest.Items = withSiteCurrency(paymentRequest.Items, requestSite(paymentRequest.SiteID))
+
 	params := request.Params{
 		Method:  "POST",
 		Body:    paymentRequest,
 		Headers: map[string]interface{}{"Authorization": "Bearer " + getAccessToken(mercadoPagoAccessToken...)},
-		URL:     BASEURL + "/checkout/preferences",
+		URL:     getBaseURL() + "/checkout/preferences",
 	}
 
 	response, err := request.New(params)
@@ -24,25 +36,31 @@ func CreatePayment(paymentRequest PaymentRequest, mercadoPagoAccessToken ...stri
 		return nil, nil, err
 	}
 
+	metadata := responseMetadata(params, response)
 	if response.StatusCode > 300 {
-		resp, err := parseError(response.RawBody)
+		resp, err := parseError(metadata)
 		return nil, resp, err
 	}
 
 	var paymentResponse PaymentResponse
 	err = json.Unmarshal(response.RawBody, &paymentResponse)
+	paymentResponse.Response = metadata
 	return &paymentResponse, nil, err
 }
 
 // UpdatePayment é o método responsável por atualizar as informações de um pagamento no MercadoPago.
+// Os itens sem moeda são enviados com a moeda do site do pagamento (SiteID ou o site configurado no CurrentSite), quando nenhum site é configurado
+// a moeda não é preenchida e o MercadoPago usa a moeda da conta.
 func UpdatePayment(paymentID string, paymentRequest PaymentRequest, mercadoPagoAccessToken ...string) (*PaymentResponse, *ErrorResponse, error) {
 
+	paymentRequest.Items = withSiteCurrency(paymentRequest.Items, requestSite(paymentRequest.SiteID))
+
 	params := request.Params{
 		Method:     "PUT",
 		PathParams: request.PathParams{paymentID},
 		Body:       paymentRequest,
 		Headers:    map[string]interface{}{"Authorization": "Bearer " + getAccessToken(mercadoPagoAccessToken...)},
-		URL:        BASEURL + "/checkout/preferences",
+		URL:        getBaseURL() + "/checkout/preferences",
 	}
 
 	response, err := request.New(params)
@@ -50,13 +68,15 @@ func UpdatePayment(paymentID string, paymentRequest PaymentRequest, mercadoPagoA
 		return nil, nil, err
 	}
 
+	metadata := responseMetadata(params, response)
 	if response.StatusCode > 300 {
-		resp, err := parseError(response.RawBody)
+		resp, err := parseError(metadata)
 		return nil, resp, err
 	}
 
 	var paymentResponse PaymentResponse
 	err = json.Unmarshal(response.RawBody, &paymentResponse)
+	paymentResponse.Response = metadata
 	return &paymentResponse, nil, err
 }
 
@@ -67,7 +87,7 @@ func GetPayment(paymentID string, mercadoPagoAccessToken ...string) (*PaymentRes
 		Method:     "GET",
 		PathParams: request.PathParams{paymentID},
 		Headers:    map[string]interface{}{"Authorization": "Bearer " + getAccessToken(mercadoPagoAccessToken...)},
-		URL:        BASEURL + "/checkout/preferences",
+		URL:        getBaseURL() + "/checkout/preferences",
 	}
 
 	response, err := request.New(params)
@@ -75,13 +95,15 @@ func GetPayment(paymentID string, mercadoPagoAccessToken ...string) (*PaymentRes
 		return nil, nil, err
 	}
 
+	metadata := responseMetadata(params, response)
 	if response.StatusCode > 300 {
-		resp, err := parseError(response.RawBody)
+		resp, err := parseError(metadata)
 		return nil, resp, err
 	}
 
 	var paymentResponse PaymentResponse
 	err = json.Unmarshal(response.RawBody, &paymentResponse)
+	paymentResponse.Response = metadata
 	return &paymentResponse, nil, err
 }
 
@@ -95,7 +117,7 @@ func SearchPayments(searchParams PaymentSearchParams, mercadoPagoAccessToken ...
 		Method:      "GET",
 		QueryParams: request.QueryParams(searchParams),
 		Headers:     map[string]interface{}{"Authorization": "Bearer " + getAccessToken(mercadoPagoAccessToken...)},
-		URL:         BASEURL + "/checkout/preferences/search",
+		URL:         getBaseURL() + "/checkout/preferences/search",
 	}
 
 	response, err := request.New(params)
@@ -103,13 +125,15 @@ func SearchPayments(searchParams PaymentSearchParams, mercadoPagoAccessToken ...
 		return nil, nil, err
 	}
 
+	metadata := responseMetadata(params, response)
 	if response.StatusCode > 300 {
-		resp, err := parseError(response.RawBody)
+		resp, err := parseError(metadata)
 		return nil, resp, err
 	}
 
 	var paymentSearchResponse PaymentSearchResponse
 	err = json.Unmarshal(response.RawBody, &paymentSearchResponse)
+	paymentSearchResponse.Response = metadata
 	return &paymentSearchResponse, nil, err
 }
 
@@ -120,7 +144,7 @@ func ConsultPayment(paymentID string, mercadoPagoAccessToken ...string) (*Paymen
 		Method:     "GET",
 		PathParams: request.PathParams{paymentID},
 		Headers:    map[string]interface{}{"Authorization": "Bearer " + getAccessToken(mercadoPagoAccessToken...)},
-		URL:        BASEURL + "/v1/payments",
+		URL:        getBaseURL() + "/v1/payments",
 	}
 
 	response, err := request.New(params)
@@ -128,77 +152,617 @@ func ConsultPayment(paymentID string, mercadoPagoAccessToken ...string) (*Paymen
 		return nil, nil, err
 	}
 
+	metadata := responseMetadata(params, response)
 	if response.StatusCode > 300 {
-		resp, err := parseError(response.RawBody)
+		resp, err := parseError(metadata)
 		return nil, resp, err
 	}
 
 	var paymentResponse PaymentConsultResponse
 	err = json.Unmarshal(response.RawBody, &paymentResponse)
+	paymentResponse.Response = metadata
 	return &paymentResponse, nil, err
 }
 
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// SearchConsultPayments é o método responsável por buscar os pagamentos na API de pagamentos do MercadoPago (/v1/payments/search), retornando as mesmas informações do ConsultPayment.
+// Diferente do SearchPayments que busca os pagamentos criados (preferências), essa busca retorna os pagamentos feitos pelos pagadores, incluindo as tentativas rejeitadas.
+func SearchConsultPayments(searchParams PaymentConsultSearchParams, mercadoPagoAccessToken ...string) (*PaymentConsultSearchResponse, *ErrorResponse, error) {
+
+	params := request.Params{
+		Method:      "GET",
+		QueryParams: request.QueryParams(searchParams),
+		Headers:     map[string]interface{}{"Authorization": "Bearer " + getAccessToken(mercadoPagoAccessToken...)},
+		URL:         getBaseURL() + "/v1/payments/search",
+	}
+
+	response, err := request.New(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata := responseMetadata(params, response)
+	if response.StatusCode > 300 {
+		resp, err := parseError(metadata)
+		return nil, resp, err
+	}
+
+	var paymentConsultSearchResponse PaymentConsultSearchResponse
+	err = json.Unmarshal(response.RawBody, &paymentConsultSearchResponse)
+	paymentConsultSearchResponse.Response = metadata
+	return &paymentConsultSearchResponse, nil, err
+}
+
+///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // GetIdentificationTypes é o método responsável retornar todos o tipos de documento de identificação do MercadoPago.
 func GetIdentificationTypes(mercadoPagoAccessToken ...string) ([]IdentificationType, *ErrorResponse, error) {
+	identificationTypes, _, errorResponse, err := GetIdentificationTypesWithResponse(mercadoPagoAccessToken...)
+	return identificationTypes, errorResponse, err
+}
+
+// GetIdentificationTypesWithResponse é o método igual ao GetIdentificationTypes, mas que também retorna os metadados da resposta HTTP (status, headers, x-request-id)
+// e o JSON original retornado pelo MercadoPago, já que a lista retornada não possui o campo Response.
+func GetIdentificationTypesWithResponse(mercadoPagoAccessToken ...string) ([]IdentificationType, ResponseMetadata, *ErrorResponse, error) {
 
 	params := request.Params{
 		Method:  "GET",
 		Headers: map[string]interface{}{"Authorization": "Bearer " + getAccessToken(mercadoPagoAccessToken...)},
-		URL:     BASEURL + "/v1/identification_types",
+		URL:     getBaseURL() + "/v1/identification_types",
 	}
 
 	response, err := request.New(params)
 	if err != nil {
-		return nil, nil, err
+		return nil, ResponseMetadata{}, nil, err
 	}
 
+	metadata := responseMetadata(params, response)
 	if response.StatusCode > 300 {
-		resp, err := parseError(response.RawBody)
-		return nil, resp, err
+		resp, err := parseError(metadata)
+		return nil, metadata, resp, err
 	}
 
 	var identificationTypes []IdentificationType
 	err = json.Unmarshal(response.RawBody, &identificationTypes)
-	return identificationTypes, nil, err
+	return identificationTypes, metadata, nil, err
 }
 
 // GetPaymentMethods é o método responsável retornar todos o tipos de documento de identificação do MercadoPago.
 func GetPaymentMethods(mercadoPagoAccessToken ...string) ([]PaymentMethod, *ErrorResponse, error) {
+	paymentMethods, _, errorResponse, err := GetPaymentMethodsWithResponse(mercadoPagoAccessToken...)
+	return paymentMethods, errorResponse, err
+}
+
+// GetPaymentMethodsWithResponse é o método igual ao GetPaymentMethods, mas que também retorna os metadados da resposta HTTP.
+func GetPaymentMethodsWithResponse(mercadoPagoAccessToken ...string) ([]PaymentMethod, ResponseMetadata, *ErrorResponse, error) {
 
 	params := request.Params{
 		Method:  "GET",
 		Headers: map[string]interface{}{"Authorization": "Bearer " + getAccessToken(mercadoPagoAccessToken...)},
-		URL:     BASEURL + "/v1/payment_methods",
+		URL:     getBaseURL() + "/v1/payment_methods",
 	}
 
 	response, err := request.New(params)
 	if err != nil {
-		return nil, nil, err
+		return nil, ResponseMetadata{}, nil, err
 	}
 
+	metadata := responseMetadata(params, response)
 	if response.StatusCode > 300 {
-		resp, err := parseError(response.RawBody)
-		return nil, resp, err
+		resp, err := parseError(metadata)
+		return nil, metadata, resp, err
 	}
 
 	var paymentMethods []PaymentMethod
 	err = json.Unmarshal(response.RawBody, &paymentMethods)
-	return paymentMethods, nil, err
+	return paymentMethods, metadata, nil, err
+}
+
+// GetInstallments é o método responsável por retornar as opções de parcelamento (e o custo de cada parcela) de um valor no MercadoPago.
+// Deve ser informado o Bin do cartão ou o ID do método de pagamento, o ID do banco emissor é opcional.
+func GetInstallments(installmentsParams InstallmentsParams, mercadoPagoAccessToken ...string) ([]Installments, *ErrorResponse, error) {
+	installments, _, errorResponse, err := GetInstallmentsWithResponse(installmentsParams, mercadoPagoAccessToken...)
+	return installments, errorResponse, err
+}
+
+// GetInstallmentsWithResponse é o método igual ao GetInstallments, mas que também retorna os metadados da resposta HTTP.
+func GetInstallmentsWithResponse(installmentsParams InstallmentsParams, mercadoPagoAccessToken ...string) ([]Installments, ResponseMetadata, *ErrorResponse, error) {
+
+	queryParams := request.QueryParams{"amount": installmentsParams.Amount.String()}
+	if installmentsParams.Bin != "" {
+		queryParams["bin"] = installmentsParams.Bin
+	}
+	if installmentsParams.PaymentMethodID != "" {
+		queryParams["payment_method_id"] = installmentsParams.PaymentMethodID
+	}
+	if installmentsParams.IssuerID != "" {
+		queryParams["issuer.id"] = installmentsParams.IssuerID
+	}
+
+	params := request.Params{
+		Method:      "GET",
+		QueryParams: queryParams,
+		Headers:     map[string]interface{}{"Authorization": "Bearer " + getAccessToken(mercadoPagoAccessToken...)},
+		URL:         getBaseURL() + "/v1/payment_methods/installments",
+	}
+
+	response, err := request.New(params)
+	if err != nil {
+		return nil, ResponseMetadata{}, nil, err
+	}
+
+	metadata := responseMetadata(params, response)
+	if response.StatusCode > 300 {
+		resp, err := parseError(metadata)
+		return nil, metadata, resp, err
+	}
+
+	var installments []Installments
+	err = json.Unmarshal(response.RawBody, &installments)
+	return installments, metadata, nil, err
+}
+
+// GetCardIssuers é o método responsável por retornar todos os bancos emissores de um método de pagamento (exemplo: master) do MercadoPago.
+func GetCardIssuers(paymentMethodID string, mercadoPagoAccessToken ...string) ([]Issuer, *ErrorResponse, error) {
+	issuers, _, errorResponse, err := GetCardIssuersWithResponse(paymentMethodID, mercadoPagoAccessToken...)
+	return issuers, errorResponse, err
+}
+
+// GetCardIssuersWithResponse é o método igual ao GetCardIssuers, mas que também retorna os metadados da resposta HTTP.
+func GetCardIssuersWithResponse(paymentMethodID string, mercadoPagoAccessToken ...string) ([]Issuer, ResponseMetadata, *ErrorResponse, error) {
+
+	params := request.Params{
+		Method:      "GET",
+		QueryParams: request.QueryParams{"payment_method_id": paymentMethodID},
+		Headers:     map[string]interface{}{"Authorization": "Bearer " + getAccessToken(mercadoPagoAccessToken...)},
+		URL:         getBaseURL() + "/v1/payment_methods/card_issuers",
+	}
+
+	response, err := request.New(params)
+	if err != nil {
+		return nil, ResponseMetadata{}, nil, err
+	}
+
+	metadata := responseMetadata(params, response)
+	if response.StatusCode > 300 {
+		resp, err := parseError(metadata)
+		return nil, metadata, resp, err
+	}
+
+	var issuers []Issuer
+	err = json.Unmarshal(response.RawBody, &issuers)
+	return issuers, metadata, nil, err
+}
+
+// CreatePixPayment é o método responsável por criar uma cobrança Pix no MercadoPago, retornando a chave Pix Copia-e-Cola e a imagem do QRCode.
+// Antes de enviar a cobrança o valor, o email e o documento de identificação do pagador são validados localmente.
+func CreatePixPayment(pixPaymentRequest PixPaymentRequest, mercadoPagoAccessToken ...string) (*PixCharge, *ErrorResponse, error) {
+
+	if err := pixPaymentRequest.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	if pixPaymentRequest.IdempotencyKey == "" {
+		idempotencyKey, err := newIdempotencyKey()
+		if err != nil {
+			return nil, nil, err
+		}
+		pixPaymentRequest.IdempotencyKey = idempotencyKey
+	}
+
+	params := request.Params{
+		Method: "POST",
+		Body: struct {
+			PixPaymentRequest
+			PaymentMethodID string `json:"payment_method_id"`
+		}{pixPaymentRequest, "pix"},
+		Headers: map[string]interface{}{
+			"Authorization":     "Bearer " + getAccessToken(mercadoPagoAccessToken...),
+			"X-Idempotency-Key": pixPaymentRequest.IdempotencyKey,
+		},
+		URL: getBaseURL() + "/v1/payments",
+	}
+
+	response, err := request.New(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata := responseMetadata(params, response)
+	if response.StatusCode > 300 {
+		resp, err := parseError(metadata)
+		return nil, resp, err
+	}
+
+	var paymentResponse PaymentConsultResponse
+	if err := json.Unmarshal(response.RawBody, &paymentResponse); err != nil {
+		return nil, nil, err
+	}
+	paymentResponse.Response = metadata
+
+	pixCharge, err := newPixCharge(paymentResponse)
+	return pixCharge, nil, err
+}
+
+// CreateBoletoPayment é o método responsável por criar um boleto (bolbradesco) ou um pagamento em lotérica (pec) no MercadoPago,
+// retornando o código de barras, a linha digitável e a URL do boleto. Antes de enviar o boleto os dados do pagador são validados localmente.
+func CreateBoletoPayment(boletoPaymentRequest BoletoPaymentRequest, mercadoPagoAccessToken ...string) (*BoletoCharge, *ErrorResponse, error) {
+
+	if boletoPaymentRequest.PaymentMethodID == "" {
+		boletoPaymentRequest.PaymentMethodID = PaymentMethodBoleto
+	}
+
+	if err := boletoPaymentRequest.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	if boletoPaymentRequest.IdempotencyKey == "" {
+		idempotencyKey, err := newIdempotencyKey()
+		if err != nil {
+			return nil, nil, err
+		}
+		boletoPaymentRequest.IdempotencyKey = idempotencyKey
+	}
+
+	params := request.Params{
+		Method: "POST",
+		Body:   boletoPaymentRequest,
+		Headers: map[string]interface{}{
+			"Authorization":     "Bearer " + getAccessToken(mercadoPagoAccessToken...),
+			"X-Idempotency-Key": boletoPaymentRequest.IdempotencyKey,
+		},
+		URL: getBaseURL() + "/v1/payments",
+	}
+
+	response, err := request.New(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata := responseMetadata(params, response)
+	if response.StatusCode > 300 {
+		resp, err := parseError(metadata)
+		return nil, resp, err
+	}
+
+	var paymentResponse PaymentConsultResponse
+	if err := json.Unmarshal(response.RawBody, &paymentResponse); err != nil {
+		return nil, nil, err
+	}
+	paymentResponse.Response = metadata
+
+	return newBoletoCharge(paymentResponse), nil, nil
+}
+
+///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// GetReportConfig é o método responsável por retornar as configurações do relatório de liquidações (ReportSettlement) ou de liberações (ReportRelease) do MercadoPago.
+func GetReportConfig(reportType ReportType, mercadoPagoAccessToken ...string) (*ReportConfig, *ErrorResponse, error) {
+
+	params := request.Params{
+		Method:  "GET",
+		Headers: map[string]interface{}{"Authorization": "Bearer " + getAccessToken(mercadoPagoAccessToken...)},
+		URL:     getBaseURL() + "/v1/account/" + string(reportType) + "/config",
+	}
+
+	response, err := request.New(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata := responseMetadata(params, response)
+	if response.StatusCode > 300 {
+		resp, err := parseError(metadata)
+		return nil, resp, err
+	}
+
+	var reportConfig ReportConfig
+	err = json.Unmarshal(response.RawBody, &reportConfig)
+	reportConfig.Response = metadata
+	return &reportConfig, nil, err
+}
+
+// CreateReportConfig é o método responsável por criar as configurações do relatório de liquidações ou de liberações, necessárias antes de gerar o primeiro relatório.
+func CreateReportConfig(reportType ReportType, reportConfig ReportConfig, mercadoPagoAccessToken ...string) (*ReportConfig, *ErrorResponse, error) {
+
+	params := request.Params{
+		Method:  "POST",
+		Body:    reportConfig,
+		Headers: map[string]interface{}{"Authorization": "Bearer " + getAccessToken(mercadoPagoAccessToken...)},
+		URL:     getBaseURL() + "/v1/account/" + string(reportType) + "/config",
+	}
+
+	response, err := request.New(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata := responseMetadata(params, response)
+	if response.StatusCode > 300 {
+		resp, err := parseError(metadata)
+		return nil, resp, err
+	}
+
+	var reportConfigResponse ReportConfig
+	err = json.Unmarshal(response.RawBody, &reportConfigResponse)
+	reportConfigResponse.Response = metadata
+	return &reportConfigResponse, nil, err
+}
+
+// UpdateReportConfig é o método responsável por atualizar as configurações do relatório de liquidações ou de liberações, somente os campos informados são alterados.
+func UpdateReportConfig(reportType ReportType, reportConfig ReportConfig, mercadoPagoAccessToken ...string) (*ReportConfig, *ErrorResponse, error) {
+
+	params := request.Params{
+		Method:  "PUT",
+		Body:    reportConfig,
+		Headers: map[string]interface{}{"Authorization": "Bearer " + getAccessToken(mercadoPagoAccessToken...)},
+		URL:     getBaseURL() + "/v1/account/" + string(reportType) + "/config",
+	}
+
+	response, err := request.New(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata := responseMetadata(params, response)
+	if response.StatusCode > 300 {
+		resp, err := parseError(metadata)
+		return nil, resp, err
+	}
+
+	var reportConfigResponse ReportConfig
+	err = json.Unmarshal(response.RawBody, &reportConfigResponse)
+	reportConfigResponse.Response = metadata
+	return &reportConfigResponse, nil, err
+}
+
+// CreateReport é o método responsável por solicitar a geração de um relatório de liquidações ou de liberações do período informado.
+// O relatório é gerado de forma assíncrona, quando ele estiver pronto o arquivo aparece no ListReports e pode ser baixado pelo DownloadReport.
+func CreateReport(reportType ReportType, beginDate, endDate time.Time, mercadoPagoAccessToken ...string) (*Report, *ErrorResponse, error) {
+
+	params := request.Params{
+		Method: "POST",
+		Body: map[string]string{
+			"begin_date": beginDate.UTC().Format("2006-01-02T15:04:05Z"),
+			"end_date":   endDate.UTC().Format("2006-01-02T15:04:05Z"),
+		},
+		Headers: map[string]interface{}{"Authorization": "Bearer " + getAccessToken(mercadoPagoAccessToken...)},
+		URL:     getBaseURL() + "/v1/account/" + string(reportType),
+	}
+
+	response, err := request.New(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata := responseMetadata(params, response)
+	if response.StatusCode > 300 {
+		resp, err := parseError(metadata)
+		return nil, resp, err
+	}
+
+	var report Report
+	err = json.Unmarshal(response.RawBody, &report)
+	report.Response = metadata
+	return &report, nil, err
+}
+
+// ListReports é o método responsável por retornar todos os relatórios de liquidações ou de liberações já gerados.
+func ListReports(reportType ReportType, mercadoPagoAccessToken ...string) ([]Report, *ErrorResponse, error) {
+	reports, _, errorResponse, err := ListReportsWithResponse(reportType, mercadoPagoAccessToken...)
+	return reports, errorResponse, err
+}
+
+// ListReportsWithResponse é o método igual ao ListReports, mas que também retorna os metadados da resposta HTTP.
+func ListReportsWithResponse(reportType ReportType, mercadoPagoAccessToken ...string) ([]Report, ResponseMetadata, *ErrorResponse, error) {
+
+	params := request.Params{
+		Method:  "GET",
+		Headers: map[string]interface{}{"Authorization": "Bearer " + getAccessToken(mercadoPagoAccessToken...)},
+		URL:     getBaseURL() + "/v1/account/" + string(reportType) + "/list",
+	}
+
+	response, err := request.New(params)
+	if err != nil {
+		return nil, ResponseMetadata{}, nil, err
+	}
+
+	metadata := responseMetadata(params, response)
+	if response.StatusCode > 300 {
+		resp, err := parseError(metadata)
+		return nil, metadata, resp, err
+	}
+
+	var reports []Report
+	err = json.Unmarshal(response.RawBody, &reports)
+	return reports, metadata, nil, err
+}
+
+// DownloadReport é o método responsável por baixar o arquivo CSV de um relatório de liquidações ou de liberações gerado (Report.FileName).
+// O arquivo é lido linha por linha pelo ReportReader conforme o método Next é chamado, sem carregar o arquivo inteiro em memória.
+// O ReportReader retornado deve ser sempre fechado. O download não possui timeout, então para cancelar um download travado ou demorado
+// basta cancelar o ctx (exemplo: context.WithTimeout), o que também interrompe a leitura das linhas pelo método Next.
+func DownloadReport(ctx context.Context, reportType ReportType, fileName string, mercadoPagoAccessToken ...string) (*ReportReader, *ErrorResponse, error) {
+
+	params := request.Params{
+		Method:  "GET",
+		Context: ctx,
+		Headers: map[string]interface{}{
+			"Authorization": "Bearer " + getAccessToken(mercadoPagoAccessToken...),
+			"Accept":        "text/csv",
+		},
+		URL: getBaseURL() + "/v1/account/" + string(reportType) + "/" + url.PathEscape(fileName),
+	}
+
+	response, body, err := request.Stream(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if body == nil {
+		resp, err := parseError(responseMetadata(params, response))
+		return nil, resp, err
+	}
+
+	reportReader, err := NewReportReader(body)
+	if err != nil {
+		body.Close()
+		return nil, nil, err
+	}
+	return reportReader, nil, nil
+}
+
+///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// GetAccountBalance é o método responsável por retornar o saldo disponível e indisponível da conta do MercadoPago informada.
+func GetAccountBalance(userID string, mercadoPagoAccessToken ...string) (*AccountBalance, *ErrorResponse, error) {
+
+	params := request.Params{
+		Method:  "GET",
+		Headers: map[string]interface{}{"Authorization": "Bearer " + getAccessToken(mercadoPagoAccessToken...)},
+		URL:     getBaseURL() + "/users/" + url.PathEscape(userID) + "/mercadopago_account/balance",
+	}
+
+	response, err := request.New(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata := responseMetadata(params, response)
+	if response.StatusCode > 300 {
+		resp, err := parseError(metadata)
+		return nil, resp, err
+	}
+
+	var accountBalance AccountBalance
+	err = json.Unmarshal(response.RawBody, &accountBalance)
+	accountBalance.Response = metadata
+	return &accountBalance, nil, err
+}
+
+// SearchAccountMovements é o método responsável por buscar as movimentações (entradas e saídas de dinheiro) da conta do MercadoPago.
+// Para percorrer todas as páginas da busca use o AccountMovementsIterator.
+func SearchAccountMovements(searchParams AccountMovementsSearchParams, mercadoPagoAccessToken ...string) (*AccountMovementsSearchResponse, *ErrorResponse, error) {
+
+	params := request.Params{
+		Method:      "GET",
+		QueryParams: request.QueryParams(searchParams),
+		Headers:     map[string]interface{}{"Authorization": "Bearer " + getAccessToken(mercadoPagoAccessToken...)},
+		URL:         getBaseURL() + "/mercadopago_account/movements/search",
+	}
+
+	response, err := request.New(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata := responseMetadata(params, response)
+	if response.StatusCode > 300 {
+		resp, err := parseError(metadata)
+		return nil, resp, err
+	}
+
+	var accountMovementsSearchResponse AccountMovementsSearchResponse
+	err = json.Unmarshal(response.RawBody, &accountMovementsSearchResponse)
+	accountMovementsSearchResponse.Response = metadata
+	return &accountMovementsSearchResponse, nil, err
+}
+
+///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// CreateTestUser é o método responsável por criar um usuário de testes (comprador ou vendedor) no site (país) informado (exemplo: MLB).
+// Os usuários de testes são usados para testar os pagamentos no sandbox, a descrição ajuda a identificar o usuário (exemplo: comprador QA).
+// Caso o site não seja informado então é usado o site configurado (CurrentSite), que precisa ter sido definido, senão é retornado o ErrUnknownSite sem chamar a API.
+func CreateTestUser(siteID string, description string, mercadoPagoAccessToken ...string) (*TestUser, *ErrorResponse, error) {
+
+	if siteID == "" {
+		siteID = CurrentSite().ID
+	}
+	if strings.TrimSpace(siteID) == "" {
+		return nil, nil, ErrUnknownSite
+	}
+
+	params := request.Params{
+		Method:  "POST",
+		Body:    map[string]string{"site_id": siteID, "description": description},
+		Headers: map[string]interface{}{"Authorization": "Bearer " + getAccessToken(mercadoPagoAccessToken...)},
+		URL:     getBaseURL() + "/users/test",
+	}
+
+	response, err := request.New(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata := responseMetadata(params, response)
+	if response.StatusCode > 300 {
+		resp, err := parseError(metadata)
+		return nil, resp, err
+	}
+
+	var testUser TestUser
+	err = json.Unmarshal(response.RawBody, &testUser)
+	if testUser.SiteID == "" {
+		testUser.SiteID = siteID
+	}
+	testUser.Response = metadata
+	return &testUser, nil, err
+}
+
+// GetMe é o método responsável por retornar as informações da conta do MercadoPago dona do access token.
+func GetMe(mercadoPagoAccessToken ...string) (*User, *ErrorResponse, error) {
+
+	params := request.Params{
+		Method:  "GET",
+		Headers: map[string]interface{}{"Authorization": "Bearer " + getAccessToken(mercadoPagoAccessToken...)},
+		URL:     getBaseURL() + "/users/me",
+	}
+
+	response, err := request.New(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata := responseMetadata(params, response)
+	if response.StatusCode > 300 {
+		resp, err := parseError(metadata)
+		return nil, resp, err
+	}
+
+	var user User
+	err = json.Unmarshal(response.RawBody, &user)
+	user.Response = metadata
+	return &user, nil, err
 }
 
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // parseError é a função que pega os dados do erro do MercadoPago e retorna em formato de Struct.
-func parseError(body []byte) (*ErrorResponse, error) {
+func parseError(metadata ResponseMetadata) (*ErrorResponse, error) {
 	var errResponse ErrorResponse
-	if err := json.Unmarshal(body, &errResponse); err != nil {
+	if err := json.Unmarshal(metadata.RawJSON, &errResponse); err != nil {
 		return nil, err
 	}
+	errResponse.Response = metadata
 	return &errResponse, nil
 }
 
+// randReader é a fonte de bytes aleatórios usada na geração das chaves de idempotência.
+var randReader io.Reader = rand.Reader
+
+// newIdempotencyKey é a função que gera uma chave de idempotência aleatória no formato UUID v4, usada no header X-Idempotency-Key.
+// Caso não seja possível ler os bytes aleatórios então retorna um erro, já que uma chave repetida faria o MercadoPago retornar o pagamento de outra cobrança.
+func newIdempotencyKey() (string, error) {
+	key := make([]byte, 16)
+	if _, err := io.ReadFull(randReader, key); err != nil {
+		return "", fmt.Errorf("mercadopago: não foi possível gerar a chave de idempotência: %w", err)
+	}
+	key[6] = key[6]&0x0F | 0x40
+	key[8] = key[8]&0x3F | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", key[0:4], key[4:6], key[6:8], key[8:10], key[10:]), nil
+}
+
 // getAccessToken é a função responsável por retornar o AccessToken do mercado pago.
 // Caso tenha sido passado um token por parametro pegamos o token passado por parametro, se não pegamos da variavel de ambiente MERCADO_PAGO_ACCESS_TOKEN.
 func getAccessToken(mercadoPagoAccessToken ...string) string {
@@ -208,3 +772,12 @@ func getAccessToken(mercadoPagoAccessToken ...string) string {
 		return os.Getenv("MERCADO_PAGO_ACCESS_TOKEN")
 	}
 }
+
+// getBaseURL é a função responsável por retornar a URL base da API do MercadoPago.
+// Caso a variavel de ambiente MERCADO_PAGO_BASE_URL esteja definida usamos ela, se não usamos a URL padrão (BASEURL).
+func getBaseURL() string {
+	if baseURL := os.Getenv("MERCADO_PAGO_BASE_URL"); baseURL != "" {
+		return strings.TrimSuffix(baseURL, "/")
+	}
+	return BASEURL
+}
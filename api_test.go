@@ -1,13 +1,44 @@
 package mercadopago
 
 import (
+	"errors"
+	"net/http"
 	"os"
+	"strconv"
 	"testing"
+	"time"
+
+	"github.com/eduardo-mior/mercadopago-sdk-go/mercadopagotest"
 )
 
-// Definindo a variavel de ambiente MERCADO_PAGO_ACCESS_TOKEN que é usada pelo SDK
-func init() {
+// testServer é o servidor de testes que emula a API do MercadoPago, assim os testes não dependem da internet nem de uma conta real.
+var testServer *mercadopagotest.Server
+
+// Iniciando o servidor de testes, apontando o SDK para ele e definindo a variavel de ambiente MERCADO_PAGO_ACCESS_TOKEN que é usada pelo SDK
+func TestMain(m *testing.M) {
 	os.Setenv("MERCADO_PAGO_ACCESS_TOKEN", "TEST-1234936262199689-021433-a3e53345eecx2de47d25336123c21fc1-144567999")
+
+	testServer = mercadopagotest.NewServer()
+	restore := testServer.Setenv()
+
+	// Pagamentos usados pelos testes de atualização e consulta
+	testServer.AddPreference(map[string]interface{}{
+		"id":                 "825927174-5423394f-06f1-4d2b-8545-35ebecf70008",
+		"external_reference": "test-00001",
+		"items":              []Item{{Title: "Pagamendo mensalidade PagueTry", Quantity: 1, UnitPrice: NewMoney(50)}},
+	})
+	testServer.AddPayment(map[string]interface{}{
+		"id":                 1241420907,
+		"transaction_amount": 50,
+		"payment_method_id":  "master",
+		"external_reference": "test-00001",
+	})
+
+	code := m.Run()
+
+	restore()
+	testServer.Close()
+	os.Exit(code)
 }
 
 // Testando geração de um pagamento
@@ -19,7 +50,7 @@ func TestSuccessOnCreatePayment(t *testing.T) {
 			{
 				Title:     "Pagamendo mensalidade PagueTry",
 				Quantity:  1,
-				UnitPrice: 50,
+				UnitPrice: NewMoney(50),
 			},
 		},
 		Payer: Payer{
@@ -58,7 +89,7 @@ func TestSuccessOnCreatePaymentWithTokenParam(t *testing.T) {
 			{
 				Title:     "Pagamendo mensalidade PagueTry",
 				Quantity:  1,
-				UnitPrice: 50,
+				UnitPrice: NewMoney(50),
 			},
 		},
 		Payer: Payer{
@@ -136,7 +167,7 @@ func TestSuccessOnUpdatePayment(t *testing.T) {
 			{
 				Title:     "Pagamendo semestralidade PagueTry",
 				Quantity:  1,
-				UnitPrice: 300,
+				UnitPrice: NewMoney(300),
 			},
 		},
 		Payer: Payer{
@@ -331,3 +362,190 @@ func TestErrorOnConsultStatusPayment(t *testing.T) {
 	}
 
 }
+
+// Testando geração de uma cobrança Pix
+func TestSuccessOnCreatePixPayment(t *testing.T) {
+
+	pixCharge, mercadopagoErr, err := CreatePixPayment(PixPaymentRequest{
+		TransactionAmount: MustParseMoney("49.90"),
+		Description:       "Pagamendo mensalidade PagueTry",
+		Payer: PaymentPayer{
+			Email:          "raniellimontagna@hotmail.com",
+			Identification: PayerIdentification{Type: "CPF", Number: "191.191.191-00"},
+		},
+	})
+
+	if err != nil {
+		t.Error("Erro inesperado!")
+		t.Error(err.Error())
+
+	} else if mercadopagoErr != nil {
+		t.Error("Erro não tratado MercadoPago!")
+		t.Error(mercadopagoErr.Message)
+
+	} else if brCode, err := pixCharge.BRCode(); err != nil || pixCharge.Status != StatusPending || len(pixCharge.QrCodePNG) == 0 {
+		t.Error("Cobrança Pix retornada incorretamente!")
+		t.Error(brCode, err)
+	}
+
+}
+
+// Testando geração de um boleto
+func TestSuccessOnCreateBoletoPayment(t *testing.T) {
+
+	boletoCharge, mercadopagoErr, err := CreateBoletoPayment(BoletoPaymentRequest{
+		TransactionAmount: MustParseMoney("150.50"),
+		Payer: PaymentPayer{
+			FirstName:      "Rannielli",
+			LastName:       "Montagna",
+			Email:          "raniellimontagna@hotmail.com",
+			Identification: PayerIdentification{Type: "CPF", Number: "19119119100"},
+			Address: &PaymentPayerAddress{
+				ZipCode:      "01310000",
+				StreetName:   "Av. Paulista",
+				StreetNumber: "1000",
+				Neighborhood: "Bela Vista",
+				City:         "São Paulo",
+				FederalUnit:  "SP",
+			},
+		},
+	})
+
+	if err != nil {
+		t.Error("Erro inesperado!")
+		t.Error(err.Error())
+
+	} else if mercadopagoErr != nil {
+		t.Error("Erro não tratado MercadoPago!")
+		t.Error(mercadopagoErr.Message)
+
+	} else if len(boletoCharge.DigitableLine) != 47 || boletoCharge.TicketURL == "" {
+		t.Error("Boleto retornado incorretamente!")
+		t.Error(boletoCharge)
+	}
+
+}
+
+// Testando tratamento de erro do servidor na consulta de um pagamento (erro 500 simulado)
+func TestServerErrorOnConsultPayment(t *testing.T) {
+
+	testServer.FailNext("GET", "/v1/payments/1241420907", http.StatusInternalServerError)
+	response, mercadopagoErr, err := ConsultPayment("1241420907")
+
+	if err != nil {
+		t.Error("Erro inesperado!")
+		t.Error(err.Error())
+
+	} else if mercadopagoErr == nil {
+		t.Error("Erro não capturado!")
+		t.Error(response)
+
+	} else if mercadopagoErr.Status != http.StatusInternalServerError || mercadopagoErr.Response.RequestID == "" {
+		t.Error("Erro retornado incorretamente!")
+		t.Error(mercadopagoErr)
+	}
+
+}
+
+// Testando tratamento de JSON malformado na consulta de um pagamento
+func TestMalformedJSONOnConsultPayment(t *testing.T) {
+
+	testServer.MalformedJSON("GET", "/v1/payments/1241420907")
+	_, mercadopagoErr, err := ConsultPayment("1241420907")
+
+	if err == nil || mercadopagoErr != nil {
+		t.Error("Erro de JSON malformado não capturado!")
+	}
+
+}
+
+// Testando que a chave de idempotência e o access token são enviados ao MercadoPago
+func TestRequestHeadersOnCreatePixPayment(t *testing.T) {
+
+	CreatePixPayment(PixPaymentRequest{
+		TransactionAmount: NewMoney(10),
+		Payer:             PaymentPayer{Email: "raniellimontagna@hotmail.com"},
+		IdempotencyKey:    "test-idempotency-key",
+	}, "TEST-token-param")
+
+	request, ok := testServer.LastRequest()
+	if !ok || request.Headers.Get("X-Idempotency-Key") != "test-idempotency-key" || request.Headers.Get("Authorization") != "Bearer TEST-token-param" {
+		t.Error("Headers enviados incorretamente!")
+		t.Error(request.Headers)
+	}
+
+}
+
+// Testando latência simulada na busca dos meios de pagamento
+func TestLatencyOnGetPaymentMethods(t *testing.T) {
+
+	testServer.Fail(mercadopagotest.Failure{Method: "GET", Path: "/v1/payment_methods", Latency: 20 * time.Millisecond, Times: 1})
+	start := time.Now()
+
+	if _, mercadopagoErr, err := GetPaymentMethods(); err != nil || mercadopagoErr != nil {
+		t.Error("Erro inesperado!")
+	} else if time.Since(start) < 20*time.Millisecond {
+		t.Error("Latência simulada não aplicada!")
+	}
+
+}
+
+// Testando reprodução de uma consulta de pagamento a partir de um cassete (testdata/cassettes/synthetic_consult_payment.json).
+// O cassete é sintético (gerado pelo servidor de testes e não gravado na sandbox), então o teste valida somente o mecanismo de reprodução.
+func TestReplayOnConsultPayment(t *testing.T) {
+
+	stop, err := mercadopagotest.UseCassette("testdata/cassettes/synthetic_consult_payment.json", mercadopagotest.CassetteReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stop()
+	requests := len(testServer.Requests())
+
+	response, mercadopagoErr, err := ConsultPayment("1241420907")
+
+	if err != nil {
+		t.Error("Erro inesperado!")
+		t.Error(err.Error())
+
+	} else if mercadopagoErr != nil {
+		t.Error("Erro não tratado MercadoPago!")
+		t.Error(mercadopagoErr.Message)
+
+	} else if response.ID != 1241420907 || response.Status != StatusApproved || len(testServer.Requests()) != requests {
+		t.Error("Pagamento não reproduzido do cassete!")
+		t.Error(response)
+	}
+
+	if _, _, err := ConsultPayment("999"); !errors.Is(err, mercadopagotest.ErrNoInteraction) {
+		t.Error("Requisição não gravada deveria retornar ErrNoInteraction!")
+		t.Error(err)
+	}
+
+}
+
+// Testando consulta de uma cobrança Pix após o pagamento ser aprovado pelo simulador do servidor de testes
+func TestSuccessOnConsultApprovedPixPayment(t *testing.T) {
+
+	pixCharge, mercadopagoErr, err := CreatePixPayment(PixPaymentRequest{
+		TransactionAmount: NewMoney(25),
+		Payer:             PaymentPayer{Email: "raniellimontagna@hotmail.com"},
+	})
+	if err != nil || mercadopagoErr != nil {
+		t.Fatal("Erro inesperado!", err, mercadopagoErr)
+	}
+
+	if err := testServer.ApprovePayment(int64(pixCharge.ID)); err != nil {
+		t.Fatal(err)
+	}
+
+	response, mercadopagoErr, err := ConsultPayment(strconv.Itoa(pixCharge.ID))
+
+	if err != nil || mercadopagoErr != nil {
+		t.Error("Erro inesperado!", err, mercadopagoErr)
+
+	} else if response.Status != StatusApproved || response.StatusDetail != "accredited" || response.DateApproved == nil {
+		t.Error("Pagamento não aprovado!")
+		t.Error(response.Status, response.StatusDetail)
+	}
+
+}
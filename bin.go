@@ -0,0 +1,100 @@
+package mercadopago
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// BinLength é o número de digitos do cartão que formam o Bin usado na identificação do método de pagamento.
+const BinLength = 6
+
+// ErrInvalidBin é o erro retornado quando o número do cartão informado não possui digitos suficientes para identificar o Bin.
+var ErrInvalidBin = errors.New("mercadopago: o número do cartão deve possuir pelo menos 6 digitos")
+
+// PaymentMethodMatch é a struct que contém o método de pagamento identificado através do Bin do cartão.
+type PaymentMethodMatch struct {
+	PaymentMethod       PaymentMethod         // Método de pagamento identificado
+	Settings            PaymentMethodSettings // Configuração do método de pagamento que corresponde ao Bin
+	AcceptsInstallments bool                  // Indica se o Bin aceita pagamentos com mais de uma parcela (installments_pattern)
+}
+
+// MatchPaymentMethodsByBin é a função que identifica offline quais métodos de pagamento correspondem ao número (ou ao começo do número) de um cartão.
+// A identificação é feita com as expressões regulares pattern e exclusion_pattern retornadas nas configurações do GetPaymentMethods.
+// Apenas os métodos de pagamento ativos são considerados. Como mais de um método pode corresponder ao mesmo Bin (exemplo: master e debmaster)
+// todos os métodos encontrados são retornados, na mesma ordem da lista recebida.
+func MatchPaymentMethodsByBin(paymentMethods []PaymentMethod, cardNumber string) ([]PaymentMethodMatch, error) {
+	bin := onlyDigits(cardNumber)
+	if len(bin) < BinLength {
+		return nil, ErrInvalidBin
+	}
+	bin = bin[:BinLength]
+
+	var matches []PaymentMethodMatch
+	for _, paymentMethod := range paymentMethods {
+		if paymentMethod.Status != "" && paymentMethod.Status != "active" {
+			continue
+		}
+
+		for _, settings := range paymentMethod.Settings {
+			if !matchPattern(settings.Bin.Pattern, bin) {
+				continue
+			}
+			if settings.Bin.ExclusionPattern != "" && matchPattern(settings.Bin.ExclusionPattern, bin) {
+				continue
+			}
+
+			matches = append(matches, PaymentMethodMatch{
+				PaymentMethod:       paymentMethod,
+				Settings:            settings,
+				AcceptsInstallments: matchPattern(settings.Bin.InstallmentsPattern, bin),
+			})
+			break
+		}
+	}
+
+	return matches, nil
+}
+
+// FindPaymentMethodByBin é a função que retorna o primeiro método de pagamento que corresponde ao número (ou ao começo do número) de um cartão.
+// Caso nenhum método de pagamento corresponda ao Bin então retorna nil.
+func FindPaymentMethodByBin(paymentMethods []PaymentMethod, cardNumber string) (*PaymentMethodMatch, error) {
+	matches, err := MatchPaymentMethodsByBin(paymentMethods, cardNumber)
+	if err != nil || len(matches) == 0 {
+		return nil, err
+	}
+	return &matches[0], nil
+}
+
+// compiledPatterns é o cache das expressões regulares dos Bins já compiladas, as expressões inválidas são guardadas como nil.
+var compiledPatterns sync.Map
+
+// matchPattern é a função que verifica se o Bin corresponde a expressão regular.
+// Expressões regulares vazias ou inválidas nunca correspondem ao Bin.
+func matchPattern(pattern, bin string) bool {
+	if pattern == "" {
+		return false
+	}
+	re, cached := compiledPatterns.Load(pattern)
+	if !cached {
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			compiled = nil
+		}
+		re, _ = compiledPatterns.LoadOrStore(pattern, compiled)
+	}
+	compiled := re.(*regexp.Regexp)
+	return compiled != nil && compiled.MatchString(bin)
+}
+
+// onlyDigits é a função que remove todos os caracteres que não são digitos de uma string (espaços, pontos, traços...).
+func onlyDigits(value string) string {
+	var builder strings.Builder
+	for _, r := range value {
+		if r >= '0' && r <= '9' {
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String()
+}
@@ -0,0 +1,78 @@
+package mercadopago
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const paymentMethodsJSON = `[
+	{
+		"id": "master", "name": "Mastercard", "payment_type_id": "credit_card", "status": "active",
+		"settings": [{
+			"bin": {"pattern": "^(5|(2(221|222|223|224|225|226|227|228|229|23|24|25|26|27|28|29|3|4|5|6|70|71|720)))", "exclusion_pattern": "^(506429|506433|502121)", "installments_pattern": "^(5|(2(221|222|223|224|225|226|227|228|229|23|24|25|26|27|28|29|3|4|5|6|70|71|720)))"},
+			"card_number": {"length": 16, "validation": "standard"},
+			"security_code": {"mode": "mandatory", "length": 3, "card_location": "back"}
+		}]
+	},
+	{
+		"id": "amex", "name": "American Express", "payment_type_id": "credit_card", "status": "active",
+		"settings": [{
+			"bin": {"pattern": "^((34)|(37))", "exclusion_pattern": null, "installments_pattern": "^((34)|(37))"},
+			"card_number": {"length": 15, "validation": "standard"},
+			"security_code": {"mode": "mandatory", "length": 4, "card_location": "front"}
+		}]
+	},
+	{
+		"id": "debelo", "name": "Elo Débito", "payment_type_id": "debit_card", "status": "active",
+		"settings": [{
+			"bin": {"pattern": "^(506429|506433)", "exclusion_pattern": null, "installments_pattern": ""},
+			"card_number": {"length": 16, "validation": "standard"},
+			"security_code": {"mode": "mandatory", "length": 3, "card_location": "back"}
+		}]
+	},
+	{
+		"id": "pix", "name": "PIX", "payment_type_id": "bank_transfer", "status": "active", "settings": []
+	}
+]`
+
+// Testando a identificação do método de pagamento através do Bin do cartão
+func TestFindPaymentMethodByBin(t *testing.T) {
+
+	var paymentMethods []PaymentMethod
+	if err := json.Unmarshal([]byte(paymentMethodsJSON), &paymentMethods); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]string{
+		"5031 4332 1540 6351": "master",
+		"2223000048400011":    "master",
+		"375365153556885":     "amex",
+		"5064 2900 0000 0000": "debelo",
+	}
+
+	for cardNumber, expected := range tests {
+		match, err := FindPaymentMethodByBin(paymentMethods, cardNumber)
+		if err != nil {
+			t.Error(err)
+		} else if match == nil {
+			t.Error("Método de pagamento não identificado para o cartão " + cardNumber)
+		} else if match.PaymentMethod.ID != expected {
+			t.Error("Método de pagamento incorreto para o cartão " + cardNumber + ": " + match.PaymentMethod.ID)
+		}
+	}
+
+	match, err := FindPaymentMethodByBin(paymentMethods, "4111111111111111")
+	if err != nil || match != nil {
+		t.Error("Não era esperado identificar nenhum método de pagamento!")
+	}
+
+	if _, err := FindPaymentMethodByBin(paymentMethods, "5031"); err != ErrInvalidBin {
+		t.Error("Era esperado o erro ErrInvalidBin!")
+	}
+
+	match, _ = FindPaymentMethodByBin(paymentMethods, "375365")
+	if match == nil || !match.AcceptsInstallments || match.Settings.SecurityCode.Length != 4 {
+		t.Error("Configurações do Bin amex incorretas!")
+	}
+
+}
@@ -0,0 +1,95 @@
+package mercadopago
+
+import "github.com/eduardo-mior/mercadopago-sdk-go/boleto"
+
+// IDs dos métodos de pagamento em dinheiro do Brasil
+const (
+	PaymentMethodBoleto = "bolbradesco" // Boleto bancário
+	PaymentMethodPEC    = "pec"         // Pagamento em lotérica
+)
+
+// Validate é o método que valida localmente um boleto antes de enviar para o MercadoPago.
+// São validados o site do pagamento (o boleto só existe no Brasil, é usado o SiteID ou o site configurado), o valor positivo, o nome, o sobrenome, o email e o documento de identificação (CPF ou CNPJ) do pagador e,
+// no caso do boleto, todos os campos do endereço do pagador, que são obrigatórios para o registro do boleto.
+func (boletoPaymentRequest BoletoPaymentRequest) Validate() error {
+	var validationErrors ValidationErrors
+
+	if !boletoPaymentRequest.TransactionAmount.IsPositive() {
+		validationErrors.add("transaction_amount", ValidationInvalidValue, "o valor do boleto deve ser maior que zero")
+	}
+
+	if boletoPaymentRequest.PaymentMethodID != "" && boletoPaymentRequest.PaymentMethodID != PaymentMethodBoleto && boletoPaymentRequest.PaymentMethodID != PaymentMethodPEC {
+		validationErrors.add("payment_method_id", ValidationInvalidValue, "o método de pagamento "+boletoPaymentRequest.PaymentMethodID+" não é um boleto")
+	}
+
+	site := requestSite(boletoPaymentRequest.SiteID)
+	if site.Known() && site.ID != SiteBrazil {
+		validationErrors.add("payment_method_id", ValidationInvalidValue, "o boleto só está disponível no site "+SiteBrazil+" (Brasil)")
+	}
+
+	payer := boletoPaymentRequest.Payer
+	validationErrors.addRequired("payer.first_name", payer.FirstName, "o nome do pagador é obrigatório")
+	validationErrors.addRequired("payer.last_name", payer.LastName, "o sobrenome do pagador é obrigatório")
+	validationErrors.addEmail("payer.email", payer.Email)
+
+	if payer.Identification.Type == "" && payer.Identification.Number == "" {
+		validationErrors.add("payer.identification", ValidationRequired, "o CPF ou CNPJ do pagador é obrigatório")
+	} else {
+		// O boleto só existe no Brasil, então o documento é sempre validado com as regras do Brasil (CPF ou CNPJ), mesmo sem site configurado
+		brazil, _ := GetSite(SiteBrazil)
+		validationErrors.addPayerIdentification("payer.identification", payer.Identification, nil, brazil)
+	}
+
+	if boletoPaymentRequest.PaymentMethodID != PaymentMethodPEC {
+		if payer.Address == nil {
+			validationErrors.add("payer.address", ValidationRequired, "o endereço do pagador é obrigatório no boleto")
+		} else {
+			validationErrors.addRequired("payer.address.zip_code", payer.Address.ZipCode, "o CEP do pagador é obrigatório")
+			validationErrors.addRequired("payer.address.street_name", payer.Address.StreetName, "a rua do pagador é obrigatória")
+			validationErrors.addRequired("payer.address.street_number", payer.Address.StreetNumber, "o número do endereço do pagador é obrigatório")
+			validationErrors.addRequired("payer.address.neighborhood", payer.Address.Neighborhood, "o bairro do pagador é obrigatório")
+			validationErrors.addRequired("payer.address.city", payer.Address.City, "a cidade do pagador é obrigatória")
+			validationErrors.addRequired("payer.address.federal_unit", payer.Address.FederalUnit, "o estado do pagador é obrigatório")
+		}
+	}
+
+	validationErrors.addURL("notification_url", boletoPaymentRequest.NotificationURL)
+
+	return validationErrors.err()
+}
+
+// newBoletoCharge é a função que monta o boleto a partir do pagamento retornado pelo MercadoPago, calculando a linha digitável a partir do código de barras.
+func newBoletoCharge(payment PaymentConsultResponse) *BoletoCharge {
+	boletoCharge := &BoletoCharge{
+		ID:               payment.ID,
+		Status:           payment.Status,
+		StatusDetail:     payment.StatusDetail,
+		PaymentMethodID:  payment.PaymentMethodID,
+		DateOfExpiration: payment.DateOfExpiration,
+		Payment:          payment,
+	}
+
+	if payment.TransactionDetails.ExternalResourceURL != nil {
+		boletoCharge.TicketURL = *payment.TransactionDetails.ExternalResourceURL
+	}
+
+	if payment.Barcode != nil {
+		boletoCharge.Barcode = payment.Barcode.Content
+		if digitableLine, err := boleto.BarcodeToDigitableLine(payment.Barcode.Content); err == nil {
+			boletoCharge.DigitableLine = digitableLine
+		}
+	}
+
+	return boletoCharge
+}
+
+// FormattedDigitableLine é o método que retorna a linha digitável formatada como impressa no boleto (exemplo: 23790.12345 60000.000005 ...).
+func (boletoCharge BoletoCharge) FormattedDigitableLine() string {
+	return boleto.FormatDigitableLine(boletoCharge.DigitableLine)
+}
+
+// BarcodeImage é o método que gera localmente a imagem PNG do código de barras do boleto (Interleaved 2 of 5).
+// A largura da barra estreita e a altura são informadas em pixels, valores menores ou iguais a zero usam as medidas padrão.
+func (boletoCharge BoletoCharge) BarcodeImage(narrowWidth, height int) ([]byte, error) {
+	return boleto.PNG(boletoCharge.Barcode, narrowWidth, height)
+}
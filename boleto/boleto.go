@@ -0,0 +1,249 @@
+// Package boleto contém as funções de conversão e validação do código de barras (44 digitos) e da linha digitável (47 digitos)
+// dos boletos bancários, seguindo o padrão da FEBRABAN, e de renderização do código de barras no padrão Interleaved 2 of 5.
+package boleto
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Tamanhos do código de barras e da linha digitável dos boletos bancários
+const (
+	BarcodeLength       = 44 // Tamanho do código de barras
+	DigitableLineLength = 47 // Tamanho da linha digitável
+)
+
+var (
+	// ErrInvalidLength é o erro retornado quando o código não possui 44 (código de barras) ou 47 (linha digitável) digitos.
+	ErrInvalidLength = errors.New("boleto: tamanho do código inválido")
+	// ErrInvalidFormat é o erro retornado quando o código possui caracteres inválidos ou não é um boleto bancário (exemplo: contas de consumo).
+	ErrInvalidFormat = errors.New("boleto: formato do código inválido")
+	// ErrInvalidCheckDigit é o erro retornado quando algum digito verificador do código não confere.
+	ErrInvalidCheckDigit = errors.New("boleto: digito verificador inválido")
+)
+
+// Datas base do fator de vencimento. O fator 1000 corresponde a 03/07/2000 e, após chegar a 9999 em 21/02/2025, o fator voltou a ser 1000 em 22/02/2025.
+var (
+	dueFactorBase      = time.Date(1997, 10, 7, 0, 0, 0, 0, time.UTC)
+	dueFactorResetBase = time.Date(2025, 2, 22, 0, 0, 0, 0, time.UTC)
+)
+
+// Boleto é a struct que contém as informações extraídas do código de barras de um boleto bancário.
+type Boleto struct {
+	Barcode       string // Código de barras (44 digitos)
+	DigitableLine string // Linha digitável (47 digitos, sem pontuação)
+	BankCode      string // Código do banco emissor (exemplo: 237 para Bradesco)
+	CurrencyCode  string // Código da moeda (9 para Real)
+	DueFactor     int    // Fator de vencimento (zero quando o boleto não possui vencimento)
+	Amount        int64  // Valor do boleto em centavos (zero quando o valor não foi informado)
+	FreeField     string // Campo livre (25 digitos) definido por cada banco
+}
+
+// Parse é a função que lê um boleto a partir do código de barras (44 digitos) ou da linha digitável (47 digitos), validando todos os digitos verificadores.
+// Espaços, pontos e traços são ignorados.
+func Parse(code string) (*Boleto, error) {
+	code = Normalize(code)
+
+	var barcode string
+	var err error
+	switch len(code) {
+	case BarcodeLength:
+		barcode = code
+		err = ValidateBarcode(barcode)
+	case DigitableLineLength:
+		barcode, err = DigitableLineToBarcode(code)
+	default:
+		return nil, ErrInvalidLength
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	digitableLine, err := BarcodeToDigitableLine(barcode)
+	if err != nil {
+		return nil, err
+	}
+
+	dueFactor, _ := strconv.Atoi(barcode[5:9])
+	amount, _ := strconv.ParseInt(barcode[9:19], 10, 64)
+
+	return &Boleto{
+		Barcode:       barcode,
+		DigitableLine: digitableLine,
+		BankCode:      barcode[0:3],
+		CurrencyCode:  barcode[3:4],
+		DueFactor:     dueFactor,
+		Amount:        amount,
+		FreeField:     barcode[19:44],
+	}, nil
+}
+
+// DueDate é o método que retorna a data de vencimento do boleto. Como o fator de vencimento é reiniciado a cada 9000 dias,
+// é retornada a data mais próxima da data de referência informada (normalmente a data atual). Retorna false quando o boleto não possui vencimento.
+func (boleto Boleto) DueDate(reference time.Time) (time.Time, bool) {
+	return DueDate(boleto.DueFactor, reference)
+}
+
+// FormattedDigitableLine é o método que retorna a linha digitável formatada (exemplo: 23790.12345 60000.000005 ...).
+func (boleto Boleto) FormattedDigitableLine() string {
+	return FormatDigitableLine(boleto.DigitableLine)
+}
+
+// DueDate é a função que converte o fator de vencimento em data, escolhendo entre o ciclo original (base 07/10/1997) e o ciclo reiniciado
+// em 22/02/2025 a data mais próxima da data de referência. Retorna false quando o fator é zero (boleto sem vencimento) ou inválido.
+func DueDate(dueFactor int, reference time.Time) (time.Time, bool) {
+	if dueFactor < 1000 || dueFactor > 9999 {
+		return time.Time{}, false
+	}
+
+	reference = time.Date(reference.Year(), reference.Month(), reference.Day(), 0, 0, 0, 0, time.UTC)
+	dueDate := dueFactorBase.AddDate(0, 0, dueFactor)
+	for candidate := dueFactorResetBase.AddDate(0, 0, dueFactor-1000); ; candidate = candidate.AddDate(0, 0, 9000) {
+		if distance(candidate, reference) >= distance(dueDate, reference) {
+			break
+		}
+		dueDate = candidate
+	}
+	return dueDate, true
+}
+
+// DueFactor é a função que converte uma data de vencimento no fator de vencimento, considerando o reinício do fator em 22/02/2025.
+func DueFactor(dueDate time.Time) int {
+	dueDate = time.Date(dueDate.Year(), dueDate.Month(), dueDate.Day(), 0, 0, 0, 0, time.UTC)
+	if dueDate.Before(dueFactorResetBase) {
+		return int(dueDate.Sub(dueFactorBase).Hours() / 24)
+	}
+	return 1000 + int(dueDate.Sub(dueFactorResetBase).Hours()/24)%9000
+}
+
+// BarcodeToDigitableLine é a função que converte o código de barras (44 digitos) na linha digitável (47 digitos), calculando os digitos verificadores de cada campo.
+func BarcodeToDigitableLine(barcode string) (string, error) {
+	barcode = Normalize(barcode)
+	if err := ValidateBarcode(barcode); err != nil {
+		return "", err
+	}
+
+	field1 := barcode[0:4] + barcode[19:24]
+	field2 := barcode[24:34]
+	field3 := barcode[34:44]
+
+	return field1 + strconv.Itoa(Mod10(field1)) +
+		field2 + strconv.Itoa(Mod10(field2)) +
+		field3 + strconv.Itoa(Mod10(field3)) +
+		barcode[4:5] + barcode[5:19], nil
+}
+
+// DigitableLineToBarcode é a função que converte a linha digitável (47 digitos) no código de barras (44 digitos), validando os digitos verificadores.
+func DigitableLineToBarcode(digitableLine string) (string, error) {
+	digitableLine = Normalize(digitableLine)
+	if len(digitableLine) != DigitableLineLength {
+		return "", ErrInvalidLength
+	}
+	if !isDigits(digitableLine) || digitableLine[0] == '8' {
+		return "", ErrInvalidFormat
+	}
+
+	fields := []string{digitableLine[0:10], digitableLine[10:21], digitableLine[21:32]}
+	for _, field := range fields {
+		if strconv.Itoa(Mod10(field[:len(field)-1])) != field[len(field)-1:] {
+			return "", ErrInvalidCheckDigit
+		}
+	}
+
+	barcode := digitableLine[0:4] + digitableLine[32:33] + digitableLine[33:47] + digitableLine[4:9] + digitableLine[10:20] + digitableLine[21:31]
+	if err := ValidateBarcode(barcode); err != nil {
+		return "", err
+	}
+	return barcode, nil
+}
+
+// ValidateBarcode é a função que valida o tamanho, o formato e o digito verificador geral (módulo 11) do código de barras.
+func ValidateBarcode(barcode string) error {
+	if len(barcode) != BarcodeLength {
+		return ErrInvalidLength
+	}
+	if !isDigits(barcode) || barcode[0] == '8' {
+		return ErrInvalidFormat
+	}
+	if strconv.Itoa(BarcodeCheckDigit(barcode)) != barcode[4:5] {
+		return ErrInvalidCheckDigit
+	}
+	return nil
+}
+
+// ValidateDigitableLine é a função que valida o tamanho, o formato e todos os digitos verificadores da linha digitável.
+func ValidateDigitableLine(digitableLine string) error {
+	_, err := DigitableLineToBarcode(digitableLine)
+	return err
+}
+
+// BarcodeCheckDigit é a função que calcula o digito verificador geral do código de barras (posição 5) pelo módulo 11, ignorando o digito da posição 5.
+// Quando o resultado é 0, 10 ou 11 o digito verificador é 1.
+func BarcodeCheckDigit(barcode string) int {
+	digit := 11 - Mod11(barcode[0:4]+barcode[5:])
+	if digit == 0 || digit == 10 || digit == 11 {
+		return 1
+	}
+	return digit
+}
+
+// Mod10 é a função que calcula o digito verificador módulo 10 usado nos três primeiros campos da linha digitável.
+// Os digitos são multiplicados por 2 e 1 alternadamente da direita para a esquerda e os resultados maiores que 9 têm os digitos somados.
+func Mod10(digits string) int {
+	sum := 0
+	weight := 2
+	for i := len(digits) - 1; i >= 0; i-- {
+		product := int(digits[i]-'0') * weight
+		sum += product/10 + product%10
+		weight = 3 - weight
+	}
+	return (10 - sum%10) % 10
+}
+
+// Mod11 é a função que retorna o resto da divisão por 11 da soma dos digitos multiplicados pelos pesos de 2 a 9 da direita para a esquerda.
+func Mod11(digits string) int {
+	sum := 0
+	weight := 2
+	for i := len(digits) - 1; i >= 0; i-- {
+		sum += int(digits[i]-'0') * weight
+		if weight++; weight > 9 {
+			weight = 2
+		}
+	}
+	return sum % 11
+}
+
+// FormatDigitableLine é a função que formata a linha digitável no padrão impresso nos boletos (exemplo: 23790.12345 60000.000005 00000.000000 1 98760000015050).
+// Caso a linha digitável não possua 47 digitos ela é retornada sem formatação.
+func FormatDigitableLine(digitableLine string) string {
+	line := Normalize(digitableLine)
+	if len(line) != DigitableLineLength {
+		return digitableLine
+	}
+	return line[0:5] + "." + line[5:10] + " " + line[10:15] + "." + line[15:21] + " " + line[21:26] + "." + line[26:32] + " " + line[32:33] + " " + line[33:47]
+}
+
+// Normalize é a função que remove os espaços, pontos e traços do código de barras ou da linha digitável.
+func Normalize(code string) string {
+	return strings.NewReplacer(" ", "", ".", "", "-", "", "\t", "", "\n", "").Replace(code)
+}
+
+// distance é a função que retorna a distância absoluta entre duas datas.
+func distance(a, b time.Time) time.Duration {
+	if a.After(b) {
+		return a.Sub(b)
+	}
+	return b.Sub(a)
+}
+
+// isDigits é a função que indica se o texto possui somente digitos.
+func isDigits(text string) bool {
+	for _, char := range text {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+	return text != ""
+}
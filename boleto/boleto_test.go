@@ -0,0 +1,125 @@
+package boleto
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+	"time"
+)
+
+const (
+	barcode       = "23791103000000150503380260102398700120000633"
+	digitableLine = "23793380296010239870201200006334110300000015050"
+)
+
+// Testando a conversão entre o código de barras e a linha digitável
+func TestConversion(t *testing.T) {
+
+	line, err := BarcodeToDigitableLine(barcode)
+	if err != nil || line != digitableLine {
+		t.Error("Linha digitável gerada incorretamente: " + line)
+	}
+
+	code, err := DigitableLineToBarcode("23793.38029 60102.398702 01200.006334 1 10300000015050")
+	if err != nil || code != barcode {
+		t.Error("Código de barras gerado incorretamente: " + code)
+	}
+
+	if formatted := FormatDigitableLine(digitableLine); formatted != "23793.38029 60102.398702 01200.006334 1 10300000015050" {
+		t.Error("Linha digitável formatada incorretamente: " + formatted)
+	}
+
+	invalid := map[string]error{
+		"2379110300000015050338026010239870012000063":      ErrInvalidLength,
+		"23792103000000150503380260102398700120000633":     ErrInvalidCheckDigit,
+		"2379110300000015050338026010239870012000063A":     ErrInvalidFormat,
+		"23793380286010239870201200006334110300000015050":  ErrInvalidCheckDigit,
+		"23793380296010239870201200006334210300000015050":  ErrInvalidCheckDigit,
+		"836200000005667800481000180975657313001589636081": ErrInvalidLength,
+	}
+	for code, expected := range invalid {
+		if _, err := Parse(code); err != expected {
+			t.Error("Erro inesperado ao ler o código " + code)
+		}
+	}
+
+}
+
+// Testando a leitura das informações do boleto
+func TestParse(t *testing.T) {
+
+	boleto, err := Parse(digitableLine)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if boleto.Barcode != barcode || boleto.BankCode != "237" || boleto.CurrencyCode != "9" || boleto.Amount != 15050 || boleto.DueFactor != 1030 || boleto.FreeField != "3380260102398700120000633" {
+		t.Error("Boleto lido incorretamente!")
+		t.Error(boleto)
+	}
+
+	dueDate, ok := boleto.DueDate(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))
+	if !ok || !dueDate.Equal(time.Date(2025, 3, 24, 0, 0, 0, 0, time.UTC)) {
+		t.Error("Data de vencimento incorreta: " + dueDate.String())
+	}
+
+	// Antes do reinício do fator o mesmo fator corresponde a uma data de 2000
+	dueDate, _ = boleto.DueDate(time.Date(2000, 7, 1, 0, 0, 0, 0, time.UTC))
+	if !dueDate.Equal(time.Date(2000, 8, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Error("Data de vencimento incorreta: " + dueDate.String())
+	}
+
+	factors := map[int]time.Time{
+		1000: time.Date(2000, 7, 3, 0, 0, 0, 0, time.UTC),
+		9999: time.Date(2025, 2, 21, 0, 0, 0, 0, time.UTC),
+		1001: time.Date(2025, 2, 23, 0, 0, 0, 0, time.UTC),
+	}
+	for factor, date := range factors {
+		if DueFactor(date) != factor {
+			t.Errorf("Fator de vencimento incorreto para %s: %d", date, DueFactor(date))
+		}
+	}
+
+	if _, ok := DueDate(0, time.Now()); ok {
+		t.Error("Boletos sem fator de vencimento não possuem vencimento!")
+	}
+
+}
+
+// Testando a renderização do código de barras no padrão Interleaved 2 of 5
+func TestImage(t *testing.T) {
+
+	bars, err := Bars(barcode)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Início (4) + 44 digitos com 5 barras cada + fim (3)
+	if len(bars) != 4+44*5+3 {
+		t.Errorf("Quantidade de barras incorreta: %d", len(bars))
+	}
+
+	// Cada par de digitos possui 2 barras largas e 3 estreitas em cada cor, somando 2*(2*3+3) = 18 barras estreitas
+	total := 0
+	for _, bar := range bars {
+		total += bar
+	}
+	if total != 4+22*18+5 {
+		t.Errorf("Largura total incorreta: %d", total)
+	}
+
+	image, err := PNG(barcode, 1, 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded, err := png.Decode(bytes.NewReader(image))
+	if err != nil || decoded.Bounds().Dx() != total+2*QuietZone || decoded.Bounds().Dy() != 50 {
+		t.Error("Imagem gerada incorretamente!")
+	}
+
+	if _, err := Image("123", 0, 0); err != ErrInvalidLength {
+		t.Error("Era esperado erro de tamanho inválido!")
+	}
+
+}
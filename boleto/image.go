@@ -0,0 +1,112 @@
+package boleto
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+)
+
+// Medidas padrão do código de barras Interleaved 2 of 5 impresso nos boletos
+const (
+	DefaultNarrowWidth = 2  // Largura padrão em pixels da barra estreita
+	DefaultHeight      = 60 // Altura padrão em pixels do código de barras
+	WideRatio          = 3  // Proporção entre a barra larga e a barra estreita
+	QuietZone          = 10 // Margem em branco em cada lado, em número de barras estreitas
+)
+
+// interleaved2of5 contém o padrão de cada digito no Interleaved 2 of 5, onde true é uma barra larga e false uma barra estreita.
+var interleaved2of5 = [10][5]bool{
+	{false, false, true, true, false}, // 0
+	{true, false, false, false, true}, // 1
+	{false, true, false, false, true}, // 2
+	{true, true, false, false, false}, // 3
+	{false, false, true, false, true}, // 4
+	{true, false, true, false, false}, // 5
+	{false, true, true, false, false}, // 6
+	{false, false, false, true, true}, // 7
+	{true, false, false, true, false}, // 8
+	{false, true, false, true, false}, // 9
+}
+
+// Bars é a função que retorna a sequência de larguras (em número de barras estreitas) das barras e dos espaços do código de barras no padrão Interleaved 2 of 5,
+// começando por uma barra. Os digitos são codificados em pares: o primeiro digito do par nas barras e o segundo nos espaços.
+func Bars(barcode string) ([]int, error) {
+	barcode = Normalize(barcode)
+	if err := ValidateBarcode(barcode); err != nil {
+		return nil, err
+	}
+
+	width := func(wide bool) int {
+		if wide {
+			return WideRatio
+		}
+		return 1
+	}
+
+	// Início: barra estreita, espaço estreito, barra estreita, espaço estreito
+	bars := []int{1, 1, 1, 1}
+	for i := 0; i < len(barcode); i += 2 {
+		black := interleaved2of5[barcode[i]-'0']
+		white := interleaved2of5[barcode[i+1]-'0']
+		for j := 0; j < 5; j++ {
+			bars = append(bars, width(black[j]), width(white[j]))
+		}
+	}
+
+	// Fim: barra larga, espaço estreito, barra estreita
+	return append(bars, WideRatio, 1, 1), nil
+}
+
+// Image é a função que renderiza o código de barras do boleto no padrão Interleaved 2 of 5, com a largura da barra estreita e a altura informadas em pixels.
+// Valores menores ou iguais a zero usam as medidas padrão.
+func Image(barcode string, narrowWidth, height int) (image.Image, error) {
+	bars, err := Bars(barcode)
+	if err != nil {
+		return nil, err
+	}
+
+	if narrowWidth <= 0 {
+		narrowWidth = DefaultNarrowWidth
+	}
+	if height <= 0 {
+		height = DefaultHeight
+	}
+
+	total := 2 * QuietZone
+	for _, bar := range bars {
+		total += bar
+	}
+
+	img := image.NewGray(image.Rect(0, 0, total*narrowWidth, height))
+	for i := range img.Pix {
+		img.Pix[i] = 255
+	}
+
+	x := QuietZone * narrowWidth
+	for i, bar := range bars {
+		if i%2 == 0 {
+			for dx := 0; dx < bar*narrowWidth; dx++ {
+				for y := 0; y < height; y++ {
+					img.SetGray(x+dx, y, color.Gray{Y: 0})
+				}
+			}
+		}
+		x += bar * narrowWidth
+	}
+	return img, nil
+}
+
+// PNG é a função que renderiza o código de barras do boleto em uma imagem PNG.
+func PNG(barcode string, narrowWidth, height int) ([]byte, error) {
+	img, err := Image(barcode, narrowWidth, height)
+	if err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, img); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
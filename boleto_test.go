@@ -0,0 +1,86 @@
+package mercadopago
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+// Testando a montagem do boleto a partir do pagamento retornado pelo MercadoPago
+func TestBoletoCharge(t *testing.T) {
+
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "payments", "boleto.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var payment PaymentConsultResponse
+	if err := json.Unmarshal(data, &payment); err != nil {
+		t.Fatal(err)
+	}
+
+	boletoCharge := newBoletoCharge(payment)
+	if boletoCharge.ID != 1241420909 || boletoCharge.PaymentMethodID != PaymentMethodBoleto || boletoCharge.TicketURL == "" || boletoCharge.DateOfExpiration == nil {
+		t.Error("Boleto montado incorretamente!")
+	}
+
+	if boletoCharge.DigitableLine != "23793380296010239870201200006334588790000015050" || boletoCharge.FormattedDigitableLine() != "23793.38029 60102.398702 01200.006334 5 88790000015050" {
+		t.Error("Linha digitável calculada incorretamente: " + boletoCharge.DigitableLine)
+	}
+
+	if image, err := boletoCharge.BarcodeImage(0, 0); err != nil || len(image) == 0 {
+		t.Error("Imagem do código de barras gerada incorretamente!")
+	}
+
+}
+
+// Testando a validação local do boleto
+func TestBoletoPaymentRequestValidate(t *testing.T) {
+
+	boletoPaymentRequest := BoletoPaymentRequest{
+		TransactionAmount: MustParseMoney("150.50"),
+		Payer: PaymentPayer{
+			FirstName:      "Eduardo",
+			LastName:       "Mior",
+			Email:          "eduardo@email.com",
+			Identification: PayerIdentification{Type: "CPF", Number: "19119119100"},
+			Address: &PaymentPayerAddress{
+				ZipCode:      "01310000",
+				StreetName:   "Av. Paulista",
+				StreetNumber: "1000",
+				Neighborhood: "Bela Vista",
+				City:         "São Paulo",
+				FederalUnit:  "SP",
+			},
+		},
+	}
+
+	if err := boletoPaymentRequest.Validate(); err != nil {
+		t.Error("Boleto válido retornou erro: " + err.Error())
+	}
+
+	boletoPaymentRequest.Payer.Address.Neighborhood = ""
+	boletoPaymentRequest.Payer.Identification = PayerIdentification{}
+	validationErrors, ok := boletoPaymentRequest.Validate().(ValidationErrors)
+	if !ok || len(validationErrors) != 2 || len(validationErrors.Field("payer.address.neighborhood")) != 1 || len(validationErrors.Field("payer.identification")) != 1 {
+		t.Error("Erros de validação incorretos!")
+		t.Error(validationErrors)
+	}
+
+	// O endereço não é obrigatório no pagamento em lotérica
+	boletoPaymentRequest.PaymentMethodID = PaymentMethodPEC
+	boletoPaymentRequest.Payer.Address = nil
+	boletoPaymentRequest.Payer.Identification = PayerIdentification{Type: "CPF", Number: "19119119100"}
+	if err := boletoPaymentRequest.Validate(); err != nil {
+		t.Error("Pagamento em lotérica válido retornou erro: " + err.Error())
+	}
+
+	// O documento é validado com as regras do Brasil mesmo sem site configurado
+	setSite(t, "")
+	boletoPaymentRequest.Payer.Identification = PayerIdentification{Type: "DNI", Number: "12345678"}
+	if validationErrors, ok := boletoPaymentRequest.Validate().(ValidationErrors); !ok || len(validationErrors.Field("payer.identification.type")) != 1 {
+		t.Error("Documento diferente de CPF ou CNPJ aceito no boleto!")
+	}
+
+}
@@ -0,0 +1,124 @@
+package mercadopago
+
+import (
+	"strconv"
+	"time"
+)
+
+// CardData é a struct que contém os dados do cartão digitados pelo pagador, antes de serem tokenizados.
+type CardData struct {
+	Number          string // Número do cartão (pode conter espaços e traços)
+	SecurityCode    string // Código de segurança do cartão (CVV)
+	ExpirationMonth int    // Mês de expiração do cartão (1 a 12)
+	ExpirationYear  int    // Ano de expiração do cartão (com 2 ou 4 digitos)
+}
+
+// ValidateCard é a função que valida localmente os dados de um cartão de acordo com as configurações (settings) do método de pagamento.
+// São validados o Bin, o tamanho e o digito verificador (Luhn) do número do cartão, o tamanho e a obrigatoriedade do código de segurança e a data de expiração.
+// Caso algum campo seja inválido então retorna um ValidationErrors com os erros de todos os campos (card_number, security_code, expiration_month e expiration_year).
+func ValidateCard(paymentMethod PaymentMethod, card CardData) error {
+	var validationErrors ValidationErrors
+
+	number := onlyDigits(card.Number)
+	settings, found := cardSettings(paymentMethod, number)
+
+	if number == "" {
+		validationErrors.add("card_number", ValidationRequired, "o número do cartão é obrigatório")
+	} else if number != removeSeparators(card.Number) {
+		validationErrors.add("card_number", ValidationInvalidFormat, "o número do cartão deve conter apenas digitos")
+	} else if !found {
+		validationErrors.add("card_number", ValidationInvalidValue, "o número do cartão não corresponde ao método de pagamento "+paymentMethod.ID)
+	} else {
+		if settings.CardNumber.Length > 0 && len(number) != settings.CardNumber.Length {
+			validationErrors.add("card_number", ValidationInvalidLength, "o número do cartão deve possuir "+strconv.Itoa(settings.CardNumber.Length)+" digitos")
+		} else if settings.CardNumber.Validation == "standard" && !luhn(number) {
+			validationErrors.add("card_number", ValidationInvalidChecksum, "o número do cartão é inválido")
+		}
+	}
+
+	securityCode := settings.SecurityCode
+	if card.SecurityCode == "" {
+		if securityCode.Mode == "mandatory" {
+			validationErrors.add("security_code", ValidationRequired, "o código de segurança é obrigatório")
+		}
+	} else if onlyDigits(card.SecurityCode) != card.SecurityCode {
+		validationErrors.add("security_code", ValidationInvalidFormat, "o código de segurança deve conter apenas digitos")
+	} else if securityCode.Length > 0 && len(card.SecurityCode) != securityCode.Length {
+		message := "o código de segurança deve possuir " + strconv.Itoa(securityCode.Length) + " digitos"
+		if securityCode.CardLocation == "front" {
+			message += " (impresso na frente do cartão)"
+		} else if securityCode.CardLocation == "back" {
+			message += " (impresso no verso do cartão)"
+		}
+		validationErrors.add("security_code", ValidationInvalidLength, message)
+	}
+
+	year := card.ExpirationYear
+	if year > 0 && year < 100 {
+		year += 2000
+	}
+
+	if card.ExpirationMonth < 1 || card.ExpirationMonth > 12 {
+		validationErrors.add("expiration_month", ValidationInvalidValue, "o mês de expiração deve estar entre 1 e 12")
+	}
+	if year <= 0 {
+		validationErrors.add("expiration_year", ValidationRequired, "o ano de expiração é obrigatório")
+	} else if card.ExpirationMonth >= 1 && card.ExpirationMonth <= 12 {
+		// O cartão é valido até o último dia do mês de expiração.
+		now := time.Now()
+		if year < now.Year() || (year == now.Year() && card.ExpirationMonth < int(now.Month())) {
+			validationErrors.add("expiration_year", ValidationExpired, "o cartão esta expirado")
+		}
+	}
+
+	return validationErrors.err()
+}
+
+// cardSettings é a função que retorna a configuração do método de pagamento que corresponde ao Bin do cartão.
+// Caso o método de pagamento não possua configurações (exemplo: meios de pagamento sem cartão) então retorna uma configuração vazia como encontrada.
+func cardSettings(paymentMethod PaymentMethod, number string) (PaymentMethodSettings, bool) {
+	if len(paymentMethod.Settings) == 0 {
+		return PaymentMethodSettings{}, true
+	}
+
+	if len(number) >= BinLength {
+		bin := number[:BinLength]
+		for _, settings := range paymentMethod.Settings {
+			if matchPattern(settings.Bin.Pattern, bin) && (settings.Bin.ExclusionPattern == "" || !matchPattern(settings.Bin.ExclusionPattern, bin)) {
+				return settings, true
+			}
+		}
+	}
+
+	// Mesmo quando o Bin não corresponde retornamos a primeira configuração para conseguir validar o código de segurança.
+	return paymentMethod.Settings[0], false
+}
+
+// removeSeparators é a função que remove os espaços e traços usados para formatar o número do cartão.
+func removeSeparators(value string) string {
+	var result []rune
+	for _, r := range value {
+		if r != ' ' && r != '-' {
+			result = append(result, r)
+		}
+	}
+	return string(result)
+}
+
+// luhn é a função que valida o digito verificador do número do cartão usando o algoritmo de Luhn (validação standard do MercadoPago).
+func luhn(number string) bool {
+	sum := 0
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		digit := int(number[i] - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+	}
+	return sum%10 == 0
+}
@@ -0,0 +1,62 @@
+package mercadopago
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// Testando a validação local dos dados do cartão de acordo com as configurações do método de pagamento
+func TestValidateCard(t *testing.T) {
+
+	var paymentMethods []PaymentMethod
+	if err := json.Unmarshal([]byte(paymentMethodsJSON), &paymentMethods); err != nil {
+		t.Fatal(err)
+	}
+	master, amex := paymentMethods[0], paymentMethods[1]
+	nextYear := time.Now().Year() + 1
+
+	err := ValidateCard(master, CardData{Number: "5031 4332 1540 6351", SecurityCode: "123", ExpirationMonth: 11, ExpirationYear: nextYear})
+	if err != nil {
+		t.Error("Erro inesperado!")
+		t.Error(err.Error())
+	}
+
+	err = ValidateCard(amex, CardData{Number: "3753 651535 56885", SecurityCode: "1234", ExpirationMonth: 1, ExpirationYear: nextYear % 100})
+	if err != nil {
+		t.Error("Erro inesperado!")
+		t.Error(err.Error())
+	}
+
+	tests := []struct {
+		paymentMethod PaymentMethod
+		card          CardData
+		field         string
+		code          string
+	}{
+		{master, CardData{Number: "5031433215406352", SecurityCode: "123", ExpirationMonth: 11, ExpirationYear: nextYear}, "card_number", ValidationInvalidChecksum},
+		{master, CardData{Number: "503143321540635", SecurityCode: "123", ExpirationMonth: 11, ExpirationYear: nextYear}, "card_number", ValidationInvalidLength},
+		{master, CardData{Number: "375365153556885", SecurityCode: "123", ExpirationMonth: 11, ExpirationYear: nextYear}, "card_number", ValidationInvalidValue},
+		{master, CardData{Number: "5031.4332.1540.6351", SecurityCode: "123", ExpirationMonth: 11, ExpirationYear: nextYear}, "card_number", ValidationInvalidFormat},
+		{master, CardData{Number: "5031433215406351", ExpirationMonth: 11, ExpirationYear: nextYear}, "security_code", ValidationRequired},
+		{amex, CardData{Number: "375365153556885", SecurityCode: "123", ExpirationMonth: 11, ExpirationYear: nextYear}, "security_code", ValidationInvalidLength},
+		{master, CardData{Number: "5031433215406351", SecurityCode: "123", ExpirationMonth: 13, ExpirationYear: nextYear}, "expiration_month", ValidationInvalidValue},
+		{master, CardData{Number: "5031433215406351", SecurityCode: "123", ExpirationMonth: 1, ExpirationYear: 2001}, "expiration_year", ValidationExpired},
+	}
+
+	for _, test := range tests {
+		err := ValidateCard(test.paymentMethod, test.card)
+		validationErrors, ok := err.(ValidationErrors)
+		if !ok {
+			t.Error("Erro de validação não capturado para o campo " + test.field)
+			continue
+		}
+
+		fieldErrors := validationErrors.Field(test.field)
+		if len(fieldErrors) != 1 || fieldErrors[0].Code != test.code {
+			t.Error("Erro de validação incorreto para o campo " + test.field + "!")
+			t.Error(validationErrors.Error())
+		}
+	}
+
+}
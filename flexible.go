@@ -0,0 +1,93 @@
+package mercadopago
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidNumber é o erro retornado quando um campo numérico não contém um número válido nem um número dentro de uma string.
+var ErrInvalidNumber = errors.New("mercadopago: número inválido")
+
+// FlexibleNumber é o tipo numérico usado nos campos em que o MercadoPago às vezes retorna um número JSON (1) e às vezes retorna um número dentro de uma string ("1"),
+// como a quantidade dos itens das informações adicionais do pagamento. Valores null e strings vazias resultam em zero.
+type FlexibleNumber float64
+
+// Float64 é o método que retorna o número como float64.
+func (number FlexibleNumber) Float64() float64 {
+	return float64(number)
+}
+
+// Int é o método que retorna o número como int, descartando as casas decimais.
+func (number FlexibleNumber) Int() int {
+	return int(number)
+}
+
+// UnmarshalJSON é o método que decoda o número a partir de um número JSON ou de um número dentro de uma string.
+func (number *FlexibleNumber) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalFlexible(data)
+	if err != nil {
+		return err
+	}
+
+	if value == "" {
+		*number = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return ErrInvalidNumber
+	}
+
+	*number = FlexibleNumber(parsed)
+	return nil
+}
+
+// FlexibleString é o tipo texto usado nos campos em que o MercadoPago às vezes retorna uma string ("123") e às vezes retorna um número JSON (123),
+// como o código de área do telefone e o número do endereço. Booleanos são convertidos para "true" e "false" e valores null resultam em uma string vazia.
+type FlexibleString string
+
+// String é o método que retorna o valor como string.
+func (flexibleString FlexibleString) String() string {
+	return string(flexibleString)
+}
+
+// UnmarshalJSON é o método que decoda o valor a partir de uma string ou de um número JSON, preservando a representação original do número.
+func (flexibleString *FlexibleString) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalFlexible(data)
+	if err != nil {
+		return err
+	}
+
+	*flexibleString = FlexibleString(value)
+	return nil
+}
+
+// unmarshalFlexible é a função que extrai o valor de um campo que pode ser uma string, um número JSON, um booleano ou null.
+func unmarshalFlexible(data []byte) (string, error) {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return "", nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var value string
+		if err := json.Unmarshal(data, &value); err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(value), nil
+	}
+
+	if bytes.Equal(data, []byte("true")) || bytes.Equal(data, []byte("false")) {
+		return string(data), nil
+	}
+
+	var value json.Number
+	if err := json.Unmarshal(data, &value); err != nil {
+		return "", ErrInvalidNumber
+	}
+	return value.String(), nil
+}
@@ -0,0 +1,65 @@
+package mercadopago
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// Testando o Unmarshal das informações adicionais do pagamento com números retornados como string
+func TestAdditionalInfoUnmarshal(t *testing.T) {
+
+	data := `{
+		"id": 1241420907,
+		"additional_info": {
+			"ip_address": "177.10.10.10",
+			"items": [
+				{"id": "1", "title": "Mensalidade", "quantity": "2", "unit_price": "49.90"},
+				{"id": "2", "title": "Frete", "quantity": 1, "unit_price": 10}
+			],
+			"payer": {
+				"first_name": "Eduardo",
+				"last_name": "Mior",
+				"phone": {"area_code": 54, "number": "999999999"},
+				"address": {"zip_code": "99999999", "street_name": "Rua", "street_number": 123},
+				"registration_date": "2022-01-25T12:30:00.000-04:00"
+			},
+			"shipments": {"receiver_address": {"zip_code": "99999999", "street_number": "10", "floor": null}}
+		}
+	}`
+
+	var payment PaymentConsultResponse
+	if err := json.Unmarshal([]byte(data), &payment); err != nil {
+		t.Fatal(err)
+	}
+
+	info := payment.AdditionalInfo
+	if info.IPAddress != "177.10.10.10" || len(info.Items) != 2 {
+		t.Fatal("Informações adicionais decodadas incorretamente!")
+	}
+
+	if info.Items[0].Quantity != 2 || info.Items[0].UnitPrice.String() != "49.90" || info.Items[1].Quantity.Int() != 1 || info.Items[1].UnitPrice.String() != "10" {
+		t.Error("Itens decodados incorretamente!")
+		t.Error(info.Items)
+	}
+
+	total := SumMoney(info.Items[0].UnitPrice.MulQuantity(info.Items[0].Quantity.Float64()), info.Items[1].UnitPrice)
+	if total.String() != "109.80" {
+		t.Error("Total dos itens incorreto: " + total.String())
+	}
+
+	if info.Payer == nil || info.Payer.Phone.AreaCode != "54" || info.Payer.Address.StreetNumber != "123" || info.Payer.RegistrationDate.IsZero() {
+		t.Error("Pagador decodado incorretamente!")
+	}
+
+	if info.Shipments == nil || info.Shipments.ReceiverAddress.StreetNumber != "10" || info.Shipments.ReceiverAddress.Floor != "" {
+		t.Error("Endereço de entrega decodado incorretamente!")
+	}
+
+	var number FlexibleNumber
+	for _, invalid := range []string{`"abc"`, `true`, `{}`} {
+		if err := json.Unmarshal([]byte(invalid), &number); err == nil {
+			t.Error("Era esperado erro ao decodar o número " + invalid)
+		}
+	}
+
+}
@@ -0,0 +1,29 @@
+package identification
+
+// validateDNI é a função que valida o DNI argentino (7 ou 8 digitos, sem digito verificador).
+func validateDNI(number string) error {
+	return validateDigits(number, 7, 8)
+}
+
+// validateCUIT é a função que valida o CUIT/CUIL argentino (11 digitos, prefixo do tipo de pessoa e digito verificador calculado com módulo 11).
+func validateCUIT(number string) error {
+	if err := validateDigits(number, 11, 11); err != nil {
+		return err
+	}
+
+	switch number[:2] {
+	case "20", "23", "24", "27", "30", "33", "34":
+	default:
+		return ErrInvalidFormat
+	}
+
+	digit := 11 - weightedSum(number, []int{5, 4, 3, 2, 7, 6, 5, 4, 3, 2})%11
+	if digit == 11 {
+		digit = 0
+	}
+
+	if digit == 10 || int(number[10]-'0') != digit {
+		return ErrInvalidCheckDigit
+	}
+	return nil
+}
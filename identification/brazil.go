@@ -0,0 +1,71 @@
+package identification
+
+// validateCPF é a função que valida o CPF (11 digitos com 2 digitos verificadores calculados com módulo 11).
+func validateCPF(number string) error {
+	if err := validateDigits(number, 11, 11); err != nil {
+		return err
+	}
+	if allSameDigit(number) {
+		return ErrInvalidCheckDigit
+	}
+
+	for length := 9; length <= 10; length++ {
+		sum := 0
+		for i := 0; i < length; i++ {
+			sum += int(number[i]-'0') * (length + 1 - i)
+		}
+
+		digit := sum * 10 % 11
+		if digit == 10 {
+			digit = 0
+		}
+
+		if int(number[length]-'0') != digit {
+			return ErrInvalidCheckDigit
+		}
+	}
+
+	return nil
+}
+
+// validateCNPJ é a função que valida o CNPJ (14 caracteres com 2 digitos verificadores calculados com módulo 11).
+// A partir de julho de 2026 a Receita Federal passou a emitir CNPJs alfanuméricos, onde os 12 primeiros caracteres podem ser letras,
+// nesse caso o valor de cada caractere no cálculo do digito verificador é o seu código ASCII menos 48.
+func validateCNPJ(number string) error {
+	if len(number) != 14 {
+		if isDigits(number) {
+			return ErrInvalidLength
+		}
+		return ErrInvalidFormat
+	}
+
+	for i, r := range number {
+		isDigit := r >= '0' && r <= '9'
+		if !isDigit && (i >= 12 || r < 'A' || r > 'Z') {
+			return ErrInvalidFormat
+		}
+	}
+
+	if allSameDigit(number) {
+		return ErrInvalidCheckDigit
+	}
+
+	weights := []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+	for length := 12; length <= 13; length++ {
+		sum := 0
+		for i := 0; i < length; i++ {
+			sum += int(number[i]-'0') * weights[len(weights)-length+i]
+		}
+
+		digit := 0
+		if sum%11 >= 2 {
+			digit = 11 - sum%11
+		}
+
+		if int(number[length]-'0') != digit {
+			return ErrInvalidCheckDigit
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package identification
+
+// validateRUT é a função que valida o RUT chileno (7 ou 8 digitos mais o digito verificador, que pode ser K, calculado com módulo 11).
+func validateRUT(number string) error {
+	if len(number) < 8 || len(number) > 9 {
+		return ErrInvalidLength
+	}
+
+	body, checkDigit := number[:len(number)-1], number[len(number)-1]
+	if !isDigits(body) || !((checkDigit >= '0' && checkDigit <= '9') || checkDigit == 'K') {
+		return ErrInvalidFormat
+	}
+
+	sum, weight := 0, 2
+	for i := len(body) - 1; i >= 0; i-- {
+		sum += int(body[i]-'0') * weight
+		weight++
+		if weight > 7 {
+			weight = 2
+		}
+	}
+
+	expected := byte('0')
+	switch digit := 11 - sum%11; digit {
+	case 11:
+		expected = '0'
+	case 10:
+		expected = 'K'
+	default:
+		expected = byte('0' + digit)
+	}
+
+	if checkDigit != expected {
+		return ErrInvalidCheckDigit
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package identification
+
+// validateCC é a função que valida a Cédula de Ciudadanía colombiana (de 3 a 10 digitos, sem digito verificador).
+func validateCC(number string) error {
+	return validateDigits(number, 3, 10)
+}
+
+// validateNIT é a função que valida o NIT colombiano. O último digito do documento normalizado é o digito verificador (DV),
+// que é calculado pela DIAN com módulo 11 usando os pesos 3, 7, 13, 17, 19, 23... da direita para a esquerda.
+func validateNIT(number string) error {
+	if err := validateDigits(number, 9, 16); err != nil {
+		return err
+	}
+
+	weights := []int{3, 7, 13, 17, 19, 23, 29, 37, 41, 43, 47, 53, 59, 67, 71}
+	body := number[:len(number)-1]
+
+	sum := 0
+	for i := 0; i < len(body); i++ {
+		sum += int(body[len(body)-1-i]-'0') * weights[i]
+	}
+
+	digit := sum % 11
+	if digit > 1 {
+		digit = 11 - digit
+	}
+
+	if int(number[len(number)-1]-'0') != digit {
+		return ErrInvalidCheckDigit
+	}
+	return nil
+}
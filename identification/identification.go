@@ -0,0 +1,139 @@
+// Package identification contém as funções de normalização e validação local dos documentos de identificação
+// aceitos pelo MercadoPago em todos os países (CPF, CNPJ, DNI, CUIT, RFC, CURP, RUT, NIT, CI...).
+package identification
+
+import (
+	"errors"
+	"strings"
+)
+
+// Tipos de documento de identificação (IDs retornados pelo GetIdentificationTypes)
+const (
+	CPF  = "CPF"  // Cadastro de Pessoas Físicas (Brasil)
+	CNPJ = "CNPJ" // Cadastro Nacional da Pessoa Jurídica (Brasil)
+	DNI  = "DNI"  // Documento Nacional de Identidad (Argentina e Peru)
+	CUIT = "CUIT" // Clave Única de Identificación Tributaria (Argentina)
+	CUIL = "CUIL" // Código Único de Identificación Laboral (Argentina)
+	RFC  = "RFC"  // Registro Federal de Contribuyentes (México)
+	CURP = "CURP" // Clave Única de Registro de Población (México)
+	RUT  = "RUT"  // Rol Único Tributario (Chile)
+	CC   = "CC"   // Cédula de Ciudadanía (Colômbia)
+	NIT  = "NIT"  // Número de Identificación Tributaria (Colômbia)
+	CI   = "CI"   // Cédula de Identidad (Uruguai)
+	RUC  = "RUC"  // Registro Único de Contribuyentes (Peru)
+)
+
+var (
+	// ErrUnsupportedType é o erro retornado quando o tipo do documento não pode ser validado localmente (exemplo: Otro, CE, Pasaporte).
+	ErrUnsupportedType = errors.New("identification: tipo de documento não suportado")
+	// ErrInvalidLength é o erro retornado quando o documento não possui a quantidade de caracteres esperada.
+	ErrInvalidLength = errors.New("identification: tamanho do documento inválido")
+	// ErrInvalidFormat é o erro retornado quando o documento possui caracteres ou formato inválido.
+	ErrInvalidFormat = errors.New("identification: formato do documento inválido")
+	// ErrInvalidCheckDigit é o erro retornado quando o digito verificador do documento não confere.
+	ErrInvalidCheckDigit = errors.New("identification: digito verificador inválido")
+)
+
+// validators contém as funções de validação de cada tipo de documento. Todas as funções recebem o documento já normalizado.
+var validators = map[string]func(string) error{
+	CPF:  validateCPF,
+	CNPJ: validateCNPJ,
+	DNI:  validateDNI,
+	CUIT: validateCUIT,
+	CUIL: validateCUIT,
+	RFC:  validateRFC,
+	CURP: validateCURP,
+	RUT:  validateRUT,
+	CC:   validateCC,
+	NIT:  validateNIT,
+	CI:   validateCI,
+	RUC:  validateRUC,
+}
+
+// Normalize é a função que remove a pontuação (pontos, traços, barras e espaços) do documento e converte as letras para maiúsculo.
+// Exemplo: "123.456.789-09" vira "12345678909" e "12.345.678-k" vira "12345678K".
+func Normalize(number string) string {
+	var builder strings.Builder
+	for _, r := range strings.ToUpper(number) {
+		if (r >= '0' && r <= '9') || (r >= 'A' && r <= 'Z') || r == 'Ñ' || r == '&' {
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String()
+}
+
+// Supported é a função que indica se o tipo de documento pode ser validado localmente pelo SDK.
+func Supported(documentType string) bool {
+	_, ok := validators[strings.ToUpper(documentType)]
+	return ok
+}
+
+// Validate é a função que normaliza e valida um documento de identificação de acordo com o seu tipo.
+// Caso o tipo do documento não seja suportado então retorna ErrUnsupportedType.
+func Validate(documentType, number string) error {
+	validator, ok := validators[strings.ToUpper(documentType)]
+	if !ok {
+		return ErrUnsupportedType
+	}
+	return validator(Normalize(number))
+}
+
+// ValidateForSite é a função que valida um documento de identificação considerando as regras do país (site) do MercadoPago.
+// Alguns tipos de documento possuem o mesmo nome em países diferentes, como o DNI que no Peru (MPE) sempre possui 8 digitos
+// e o CI que na Argentina (MLA) não possui digito verificador como no Uruguai (MLU).
+func ValidateForSite(siteID, documentType, number string) error {
+	documentType = strings.ToUpper(documentType)
+	number = Normalize(number)
+
+	switch {
+	case siteID == "MPE" && documentType == DNI:
+		return validateDigits(number, 8, 8)
+	case siteID == "MLA" && documentType == CI:
+		return validateDigits(number, 7, 9)
+	}
+
+	validator, ok := validators[documentType]
+	if !ok {
+		return ErrUnsupportedType
+	}
+	return validator(number)
+}
+
+// isDigits é a função que indica se a string possui somente digitos.
+func isDigits(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// validateDigits é a função que valida se o documento possui somente digitos e se o tamanho esta entre o mínimo e o máximo.
+func validateDigits(number string, min, max int) error {
+	if !isDigits(number) {
+		return ErrInvalidFormat
+	}
+	if len(number) < min || len(number) > max {
+		return ErrInvalidLength
+	}
+	return nil
+}
+
+// allSameDigit é a função que indica se todos os caracteres do documento são iguais (exemplo: 11111111111), esses documentos
+// possuem digito verificador válido porém não são documentos válidos.
+func allSameDigit(number string) bool {
+	return strings.Count(number, number[:1]) == len(number)
+}
+
+// weightedSum é a função que soma os digitos do documento multiplicados pelos seus respectivos pesos.
+func weightedSum(number string, weights []int) int {
+	sum := 0
+	for i, weight := range weights {
+		sum += int(number[i]-'0') * weight
+	}
+	return sum
+}
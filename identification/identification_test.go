@@ -0,0 +1,92 @@
+package identification
+
+import "testing"
+
+// Testando a normalização dos documentos de identificação
+func TestNormalize(t *testing.T) {
+
+	tests := map[string]string{
+		"123.456.789-09":     "12345678909",
+		"12.345.678/0001-95": "12345678000195",
+		"12.345.678-k":       "12345678K",
+		" 20-12345678-6 ":    "20123456786",
+	}
+
+	for number, expected := range tests {
+		if normalized := Normalize(number); normalized != expected {
+			t.Error("Normalização incorreta de " + number + ": " + normalized)
+		}
+	}
+
+}
+
+// Testando a validação de documentos de identificação válidos e inválidos de todos os países
+func TestValidate(t *testing.T) {
+
+	tests := []struct {
+		documentType string
+		number       string
+		expected     error
+	}{
+		{CPF, "123.456.789-09", nil},
+		{CPF, "529.982.247-25", nil},
+		{CPF, "529.982.247-26", ErrInvalidCheckDigit},
+		{CPF, "111.111.111-11", ErrInvalidCheckDigit},
+		{CPF, "1234567890", ErrInvalidLength},
+		{CNPJ, "11.222.333/0001-81", nil},
+		{CNPJ, "11.222.333/0001-82", ErrInvalidCheckDigit},
+		{CNPJ, "12.ABC.345/01DE-35", nil},
+		{CNPJ, "12.ABC.345/01DE-36", ErrInvalidCheckDigit},
+		{DNI, "12.345.678", nil},
+		{DNI, "12345", ErrInvalidLength},
+		{CUIT, "20-12345678-6", nil},
+		{CUIT, "20-12345678-5", ErrInvalidCheckDigit},
+		{CUIL, "27-12345678-0", nil},
+		{CUIT, "99-12345678-6", ErrInvalidFormat},
+		{RFC, "GODE561231GR8", nil},
+		{RFC, "GODE561231GR9", ErrInvalidCheckDigit},
+		{RFC, "GODE561331GR8", ErrInvalidFormat},
+		{CURP, "HEGG560427MVZRRL04", nil},
+		{CURP, "HEGG560427MVZRRL05", ErrInvalidCheckDigit},
+		{CURP, "HEGG561327MVZRRL04", ErrInvalidFormat},
+		{RUT, "12.345.678-5", nil},
+		{RUT, "11.111.111-1", nil},
+		{RUT, "12.345.678-K", ErrInvalidCheckDigit},
+		{CC, "1.020.304.050", nil},
+		{NIT, "800.197.268-4", nil},
+		{NIT, "800.197.268-5", ErrInvalidCheckDigit},
+		{CI, "1.234.567-2", nil},
+		{CI, "1.234.567-3", ErrInvalidCheckDigit},
+		{RUC, "20100070970", nil},
+		{RUC, "20100070971", ErrInvalidCheckDigit},
+		{"Otro", "123", ErrUnsupportedType},
+	}
+
+	for _, test := range tests {
+		if err := Validate(test.documentType, test.number); err != test.expected {
+			t.Errorf("Validação incorreta do %s %s: esperado %v, recebido %v", test.documentType, test.number, test.expected, err)
+		}
+	}
+
+}
+
+// Testando as regras específicas de cada país
+func TestValidateForSite(t *testing.T) {
+
+	if err := ValidateForSite("MPE", DNI, "1234567"); err != ErrInvalidLength {
+		t.Error("O DNI peruano deve possuir 8 digitos!")
+	}
+
+	if err := ValidateForSite("MLA", DNI, "1234567"); err != nil {
+		t.Error("O DNI argentino pode possuir 7 digitos!")
+	}
+
+	if err := ValidateForSite("MLA", CI, "12345678"); err != nil {
+		t.Error("A CI argentina não possui digito verificador!")
+	}
+
+	if err := ValidateForSite("MLU", CI, "12345678"); err != ErrInvalidCheckDigit {
+		t.Error("A CI uruguaia possui digito verificador!")
+	}
+
+}
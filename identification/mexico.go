@@ -0,0 +1,83 @@
+package identification
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	rfcPattern  = regexp.MustCompile(`^[A-ZÑ&]{3,4}[0-9]{6}[A-Z0-9]{2}[0-9A]$`)
+	curpPattern = regexp.MustCompile(`^[A-Z][AEIOUX][A-Z]{2}[0-9]{6}[HMX](AS|BC|BS|CC|CL|CM|CS|CH|DF|DG|GT|GR|HG|JC|MC|MN|MS|NT|NL|OC|PL|QT|QR|SP|SL|SR|TC|TS|TL|VZ|YN|ZS|NE)[B-DF-HJ-NP-TV-Z]{3}[A-Z0-9][0-9]$`)
+)
+
+// validateRFC é a função que valida o RFC mexicano (12 caracteres para pessoas morais e 13 para pessoas físicas),
+// validando o formato, a data de nascimento/constituição e o digito verificador (homoclave).
+func validateRFC(number string) error {
+	runes := []rune(number)
+	if len(runes) < 12 || len(runes) > 13 {
+		return ErrInvalidLength
+	}
+	if !rfcPattern.MatchString(number) {
+		return ErrInvalidFormat
+	}
+
+	date := string(runes[len(runes)-9 : len(runes)-3])
+	if _, err := time.Parse("060102", date); err != nil {
+		return ErrInvalidFormat
+	}
+
+	// O RFC das pessoas morais possui 12 caracteres, para o cálculo completamos com um espaço a esquerda.
+	if len(runes) == 12 {
+		runes = append([]rune{' '}, runes...)
+	}
+
+	const dictionary = "0123456789ABCDEFGHIJKLMN&OPQRSTUVWXYZ Ñ"
+	sum := 0
+	for i, r := range runes[:12] {
+		sum += runeIndex(dictionary, r) * (13 - i)
+	}
+
+	expected := '0'
+	if remainder := sum % 11; remainder != 0 {
+		if digit := 11 - remainder; digit == 10 {
+			expected = 'A'
+		} else {
+			expected = rune('0' + digit)
+		}
+	}
+
+	if runes[12] != expected {
+		return ErrInvalidCheckDigit
+	}
+	return nil
+}
+
+// validateCURP é a função que valida o CURP mexicano (18 caracteres), validando o formato, a data de nascimento e o digito verificador.
+func validateCURP(number string) error {
+	if len([]rune(number)) != 18 {
+		return ErrInvalidLength
+	}
+	if !curpPattern.MatchString(number) {
+		return ErrInvalidFormat
+	}
+	if _, err := time.Parse("060102", number[4:10]); err != nil {
+		return ErrInvalidFormat
+	}
+
+	const dictionary = "0123456789ABCDEFGHIJKLMNÑOPQRSTUVWXYZ"
+	sum := 0
+	for i, r := range number[:17] {
+		sum += runeIndex(dictionary, r) * (18 - i)
+	}
+
+	if int(number[17]-'0') != (10-sum%10)%10 {
+		return ErrInvalidCheckDigit
+	}
+	return nil
+}
+
+// runeIndex é a função que retorna a posição (em runes) de um caractere no dicionário usado no cálculo do digito verificador.
+func runeIndex(dictionary string, r rune) int {
+	return len([]rune(dictionary[:strings.IndexRune(dictionary, r)]))
+}
@@ -0,0 +1,24 @@
+package identification
+
+// validateRUC é a função que valida o RUC peruano (11 digitos com digito verificador calculado com módulo 11).
+func validateRUC(number string) error {
+	if err := validateDigits(number, 11, 11); err != nil {
+		return err
+	}
+
+	switch number[:2] {
+	case "10", "15", "16", "17", "20":
+	default:
+		return ErrInvalidFormat
+	}
+
+	digit := 11 - weightedSum(number, []int{5, 4, 3, 2, 7, 6, 5, 4, 3, 2})%11
+	if digit >= 10 {
+		digit -= 10
+	}
+
+	if int(number[10]-'0') != digit {
+		return ErrInvalidCheckDigit
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package identification
+
+// validateCI é a função que valida a Cédula de Identidad uruguaia (de 7 a 8 digitos, sendo o último o digito verificador).
+func validateCI(number string) error {
+	if err := validateDigits(number, 7, 8); err != nil {
+		return err
+	}
+
+	// Cédulas antigas possuem apenas 6 digitos mais o digito verificador, completamos com zero a esquerda.
+	if len(number) == 7 {
+		number = "0" + number
+	}
+
+	digit := (10 - weightedSum(number, []int{2, 9, 8, 7, 6, 3, 4})%10) % 10
+	if int(number[7]-'0') != digit {
+		return ErrInvalidCheckDigit
+	}
+	return nil
+}
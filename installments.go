@@ -0,0 +1,80 @@
+package mercadopago
+
+import "strings"
+
+// IsInterestFree é o método que indica se a opção de parcelamento é sem juros (taxa de juros igual a zero).
+func (payerCost PayerCost) IsInterestFree() bool {
+	return payerCost.InstallmentRate == 0
+}
+
+// HasLabel é o método que indica se a opção de parcelamento possui uma determinada etiqueta (exemplo: recommended_installment).
+func (payerCost PayerCost) HasLabel(label string) bool {
+	for _, l := range payerCost.Labels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
+
+// Rates é o método que retorna as taxas informadas nas etiquetas da opção de parcelamento.
+// O MercadoPago retorna as taxas em uma etiqueta no formato "CFT_0,00%|TEA_0,00%", então retornamos um map com {"CFT": "0,00%", "TEA": "0,00%"}.
+func (payerCost PayerCost) Rates() map[string]string {
+	rates := map[string]string{}
+	for _, label := range payerCost.Labels {
+		if !strings.Contains(label, "%") {
+			continue
+		}
+		for _, rate := range strings.Split(label, "|") {
+			parts := strings.SplitN(rate, "_", 2)
+			if len(parts) == 2 {
+				rates[parts[0]] = parts[1]
+			}
+		}
+	}
+	return rates
+}
+
+// CFT é o método que retorna o Custo Financeiro Total da opção de parcelamento (exemplo: 0,00%).
+func (payerCost PayerCost) CFT() string {
+	return payerCost.Rates()["CFT"]
+}
+
+// TEA é o método que retorna a Taxa Efetiva Anual da opção de parcelamento (exemplo: 0,00%).
+func (payerCost PayerCost) TEA() string {
+	return payerCost.Rates()["TEA"]
+}
+
+// InterestFree é o método que retorna somente as opções de parcelamento sem juros.
+func (installments Installments) InterestFree() []PayerCost {
+	var payerCosts []PayerCost
+	for _, payerCost := range installments.PayerCosts {
+		if payerCost.IsInterestFree() {
+			payerCosts = append(payerCosts, payerCost)
+		}
+	}
+	return payerCosts
+}
+
+// MaxInterestFree é o método que retorna a opção de parcelamento sem juros com o maior número de parcelas.
+// Caso não exista nenhuma opção de parcelamento sem juros então retorna nil.
+func (installments Installments) MaxInterestFree() *PayerCost {
+	var max *PayerCost
+	for i, payerCost := range installments.PayerCosts {
+		if payerCost.IsInterestFree() && (max == nil || payerCost.Installments > max.Installments) {
+			max = &installments.PayerCosts[i]
+		}
+	}
+	return max
+}
+
+// Find é o método que retorna a opção de parcelamento com o número de parcelas informado.
+// Caso não exista nenhuma opção com esse número de parcelas então retorna nil.
+func (installments Installments) Find(numberOfInstallments int) *PayerCost {
+	for i, payerCost := range installments.PayerCosts {
+		if payerCost.Installments == numberOfInstallments {
+			return &installments.PayerCosts[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package mercadopago
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const installmentsJSON = `[{
+	"payment_method_id": "master",
+	"payment_type_id": "credit_card",
+	"issuer": {"id": "24", "name": "Mastercard"},
+	"processing_mode": "aggregator",
+	"merchant_account_id": null,
+	"payer_costs": [
+		{"installments": 1, "installment_rate": 0, "labels": ["CFT_0,00%|TEA_0,00%"], "recommended_message": "1 parcela de R$ 100,00 (R$ 100,00)", "installment_amount": 100, "total_amount": 100},
+		{"installments": 3, "installment_rate": 0, "labels": ["CFT_0,00%|TEA_0,00%", "recommended_installment"], "recommended_message": "3 parcelas de R$ 33,33 (R$ 100,00)", "installment_amount": 33.33, "total_amount": 100},
+		{"installments": 6, "installment_rate": 8.97, "labels": ["CFT_187,35%|TEA_180,16%"], "recommended_message": "6 parcelas de R$ 18,16 (R$ 108,97)", "installment_amount": 18.16, "total_amount": 108.97}
+	]
+}]`
+
+// Testando os helpers de seleção das opções de parcelamento
+func TestInstallmentsHelpers(t *testing.T) {
+
+	var installments []Installments
+	if err := json.Unmarshal([]byte(installmentsJSON), &installments); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(installments[0].InterestFree()) != 2 {
+		t.Error("Era esperado 2 opções de parcelamento sem juros!")
+	}
+
+	max := installments[0].MaxInterestFree()
+	if max == nil || max.Installments != 3 || !max.HasLabel("recommended_installment") {
+		t.Error("Opção de parcelamento sem juros com mais parcelas incorreta!")
+		t.Error(max)
+	}
+
+	sixInstallments := installments[0].Find(6)
+	if sixInstallments == nil || sixInstallments.IsInterestFree() {
+		t.Fatal("Opção de parcelamento de 6 parcelas incorreta!")
+	}
+
+	if sixInstallments.CFT() != "187,35%" || sixInstallments.TEA() != "180,16%" {
+		t.Error("Taxas CFT/TEA incorretas!")
+		t.Error(sixInstallments.Rates())
+	}
+
+	if installments[0].Find(12) != nil {
+		t.Error("Não era esperado encontrar a opção de 12 parcelas!")
+	}
+
+}
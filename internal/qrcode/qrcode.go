@@ -0,0 +1,556 @@
+// Package qrcode é uma implementação mínima do gerador de QR Codes (ISO/IEC 18004) usada para renderizar os QR Codes do Pix localmente.
+// Somente o modo de codificação byte é suportado, que é suficiente para os códigos EMV do Pix (BR Code).
+package qrcode
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+)
+
+// Level é o nível de correção de erros do QR Code.
+type Level int
+
+const (
+	LevelL Level = iota // Recupera até 7% dos dados
+	LevelM              // Recupera até 15% dos dados (padrão do Pix)
+	LevelQ              // Recupera até 25% dos dados
+	LevelH              // Recupera até 30% dos dados
+)
+
+// QuietZone é o número de módulos da margem em branco obrigatória ao redor do QR Code.
+const QuietZone = 4
+
+// ErrDataTooLong é o erro retornado quando os dados não cabem na maior versão (40) do QR Code.
+var ErrDataTooLong = errors.New("qrcode: dados muito longos")
+
+// formatBits contém o indicador de cada nível de correção de erros usado nas informações de formato.
+var formatBits = [4]int{1, 0, 3, 2}
+
+// eccCodewordsPerBlock contém o número de codewords de correção de erros por bloco de cada nível e versão.
+var eccCodewordsPerBlock = [4][41]int{
+	{-1, 7, 10, 15, 20, 26, 18, 20, 24, 30, 18, 20, 24, 26, 30, 22, 24, 28, 30, 28, 28, 28, 28, 30, 30, 26, 28, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	{-1, 10, 16, 26, 18, 24, 16, 18, 22, 22, 26, 30, 22, 22, 24, 24, 28, 28, 26, 26, 26, 26, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28},
+	{-1, 13, 22, 18, 26, 18, 24, 18, 22, 20, 24, 28, 26, 24, 20, 30, 24, 28, 28, 26, 30, 28, 30, 30, 30, 30, 28, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	{-1, 17, 28, 22, 16, 22, 28, 26, 26, 24, 28, 24, 28, 22, 24, 24, 30, 28, 28, 26, 28, 30, 24, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+}
+
+// numErrorCorrectionBlocks contém o número de blocos de correção de erros de cada nível e versão.
+var numErrorCorrectionBlocks = [4][41]int{
+	{-1, 1, 1, 1, 1, 1, 2, 2, 2, 2, 4, 4, 4, 4, 4, 6, 6, 6, 6, 7, 8, 8, 9, 9, 10, 12, 12, 12, 13, 14, 15, 16, 17, 18, 19, 19, 20, 21, 22, 24, 25},
+	{-1, 1, 1, 1, 2, 2, 4, 4, 4, 5, 5, 5, 8, 9, 9, 10, 10, 11, 13, 14, 16, 17, 17, 18, 20, 21, 23, 25, 26, 28, 29, 31, 33, 35, 37, 38, 40, 43, 45, 47, 49},
+	{-1, 1, 1, 2, 2, 4, 4, 6, 6, 8, 8, 8, 10, 12, 16, 12, 17, 16, 18, 21, 20, 23, 23, 25, 27, 29, 34, 34, 35, 38, 40, 43, 45, 48, 51, 53, 56, 59, 62, 65, 68},
+	{-1, 1, 1, 2, 4, 4, 4, 5, 6, 8, 8, 11, 11, 16, 16, 18, 16, 19, 21, 25, 25, 25, 34, 30, 32, 35, 37, 40, 42, 45, 48, 51, 54, 57, 60, 63, 66, 70, 74, 77, 81},
+}
+
+// Code é a matriz de módulos de um QR Code já gerado.
+type Code struct {
+	Version int      // Versão do QR Code (1 a 40)
+	Level   Level    // Nível de correção de erros
+	Size    int      // Número de módulos de cada lado (sem a margem)
+	modules [][]bool // Módulos escuros (true) e claros (false), indexados por [linha][coluna]
+	isFunc  [][]bool // Módulos que fazem parte dos padrões fixos (não recebem dados nem máscara)
+}
+
+// Encode é a função que gera o QR Code de menor versão possível que comporta os dados informados no nível de correção informado.
+func Encode(data []byte, level Level) (*Code, error) {
+	version := 0
+	for v := 1; v <= 40; v++ {
+		if dataBits(v, len(data)) <= numDataCodewords(v, level)*8 {
+			version = v
+			break
+		}
+	}
+	if version == 0 {
+		return nil, ErrDataTooLong
+	}
+
+	// Montando os bits de dados: modo byte (0100), quantidade de bytes, dados, terminador e bytes de preenchimento.
+	capacity := numDataCodewords(version, level) * 8
+	bits := &bitBuffer{}
+	bits.append(0x4, 4)
+	bits.append(len(data), charCountBits(version))
+	for _, b := range data {
+		bits.append(int(b), 8)
+	}
+	bits.append(0, min(4, capacity-bits.len()))
+	bits.append(0, (8-bits.len()%8)%8)
+	for pad := 0xEC; bits.len() < capacity; pad ^= 0xEC ^ 0x11 {
+		bits.append(pad, 8)
+	}
+
+	code := newCode(version, level)
+	code.drawFunctionPatterns()
+	code.drawCodewords(code.addECCAndInterleave(bits.bytes()))
+
+	// Escolhendo a máscara com a menor penalidade.
+	bestMask, bestPenalty := 0, -1
+	for mask := 0; mask < 8; mask++ {
+		code.applyMask(mask)
+		code.drawFormatBits(mask)
+		if penalty := code.penalty(); bestPenalty < 0 || penalty < bestPenalty {
+			bestMask, bestPenalty = mask, penalty
+		}
+		code.applyMask(mask)
+	}
+	code.applyMask(bestMask)
+	code.drawFormatBits(bestMask)
+
+	return code, nil
+}
+
+// Dark é o método que indica se o módulo da coluna x e da linha y é escuro. Coordenadas fora do QR Code são consideradas claras.
+func (code *Code) Dark(x, y int) bool {
+	return x >= 0 && y >= 0 && x < code.Size && y < code.Size && code.modules[y][x]
+}
+
+// Image é o método que renderiza o QR Code (com a margem obrigatória) em uma imagem com aproximadamente size pixels de largura e altura.
+// Cada módulo ocupa um número inteiro de pixels, com no mínimo 1 pixel por módulo.
+func (code *Code) Image(size int) image.Image {
+	modules := code.Size + 2*QuietZone
+	scale := size / modules
+	if scale < 1 {
+		scale = 1
+	}
+
+	img := image.NewGray(image.Rect(0, 0, modules*scale, modules*scale))
+	for y := 0; y < modules*scale; y++ {
+		for x := 0; x < modules*scale; x++ {
+			if code.Dark(x/scale-QuietZone, y/scale-QuietZone) {
+				img.SetGray(x, y, color.Gray{Y: 0})
+			} else {
+				img.SetGray(x, y, color.Gray{Y: 255})
+			}
+		}
+	}
+	return img
+}
+
+// PNG é o método que renderiza o QR Code em uma imagem PNG com aproximadamente size pixels de largura e altura.
+func (code *Code) PNG(size int) ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, code.Image(size)); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// newCode é a função que cria a matriz vazia de um QR Code.
+func newCode(version int, level Level) *Code {
+	size := version*4 + 17
+	code := &Code{Version: version, Level: level, Size: size}
+	code.modules = make([][]bool, size)
+	code.isFunc = make([][]bool, size)
+	for i := range code.modules {
+		code.modules[i] = make([]bool, size)
+		code.isFunc[i] = make([]bool, size)
+	}
+	return code
+}
+
+// setFunction é o método que desenha um módulo de um padrão fixo.
+func (code *Code) setFunction(x, y int, dark bool) {
+	code.modules[y][x] = dark
+	code.isFunc[y][x] = true
+}
+
+// drawFunctionPatterns é o método que desenha os padrões fixos: sincronismo, localizadores, alinhamento, formato e versão.
+func (code *Code) drawFunctionPatterns() {
+	for i := 0; i < code.Size; i++ {
+		code.setFunction(6, i, i%2 == 0)
+		code.setFunction(i, 6, i%2 == 0)
+	}
+
+	code.drawFinderPattern(3, 3)
+	code.drawFinderPattern(code.Size-4, 3)
+	code.drawFinderPattern(3, code.Size-4)
+
+	positions := alignmentPatternPositions(code.Version)
+	last := len(positions) - 1
+	for i, x := range positions {
+		for j, y := range positions {
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+			code.drawAlignmentPattern(x, y)
+		}
+	}
+
+	code.drawFormatBits(0)
+	code.drawVersion()
+}
+
+// drawFinderPattern é o método que desenha um padrão localizador (com o separador) centralizado no módulo informado.
+func (code *Code) drawFinderPattern(x, y int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			dist := max(abs(dx), abs(dy))
+			xx, yy := x+dx, y+dy
+			if xx >= 0 && xx < code.Size && yy >= 0 && yy < code.Size {
+				code.setFunction(xx, yy, dist != 2 && dist != 4)
+			}
+		}
+	}
+}
+
+// drawAlignmentPattern é o método que desenha um padrão de alinhamento centralizado no módulo informado.
+func (code *Code) drawAlignmentPattern(x, y int) {
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			code.setFunction(x+dx, y+dy, max(abs(dx), abs(dy)) != 1)
+		}
+	}
+}
+
+// drawFormatBits é o método que desenha as duas cópias das informações de formato (nível de correção e máscara).
+func (code *Code) drawFormatBits(mask int) {
+	data := formatBits[code.Level]<<3 | mask
+	rem := data
+	for i := 0; i < 10; i++ {
+		rem = (rem << 1) ^ ((rem >> 9) * 0x537)
+	}
+	bits := (data<<10 | rem) ^ 0x5412
+
+	for i := 0; i <= 5; i++ {
+		code.setFunction(8, i, bit(bits, i))
+	}
+	code.setFunction(8, 7, bit(bits, 6))
+	code.setFunction(8, 8, bit(bits, 7))
+	code.setFunction(7, 8, bit(bits, 8))
+	for i := 9; i < 15; i++ {
+		code.setFunction(14-i, 8, bit(bits, i))
+	}
+
+	for i := 0; i < 8; i++ {
+		code.setFunction(code.Size-1-i, 8, bit(bits, i))
+	}
+	for i := 8; i < 15; i++ {
+		code.setFunction(8, code.Size-15+i, bit(bits, i))
+	}
+	code.setFunction(8, code.Size-8, true)
+}
+
+// drawVersion é o método que desenha as duas cópias das informações de versão (somente versões 7 ou maiores).
+func (code *Code) drawVersion() {
+	if code.Version < 7 {
+		return
+	}
+
+	rem := code.Version
+	for i := 0; i < 12; i++ {
+		rem = (rem << 1) ^ ((rem >> 11) * 0x1F25)
+	}
+	bits := code.Version<<12 | rem
+
+	for i := 0; i < 18; i++ {
+		a, b := code.Size-11+i%3, i/3
+		code.setFunction(a, b, bit(bits, i))
+		code.setFunction(b, a, bit(bits, i))
+	}
+}
+
+// addECCAndInterleave é o método que divide os dados em blocos, calcula os codewords de correção de erros (Reed-Solomon) de cada bloco e intercala os blocos.
+func (code *Code) addECCAndInterleave(data []byte) []byte {
+	numBlocks := numErrorCorrectionBlocks[code.Level][code.Version]
+	blockECCLen := eccCodewordsPerBlock[code.Level][code.Version]
+	rawCodewords := numRawDataModules(code.Version) / 8
+	numShortBlocks := numBlocks - rawCodewords%numBlocks
+	shortBlockLen := rawCodewords / numBlocks
+
+	divisor := reedSolomonDivisor(blockECCLen)
+	blocks := make([][]byte, numBlocks)
+	for i, k := 0, 0; i < numBlocks; i++ {
+		dataLen := shortBlockLen - blockECCLen
+		if i >= numShortBlocks {
+			dataLen++
+		}
+		block := append([]byte(nil), data[k:k+dataLen]...)
+		k += dataLen
+		ecc := reedSolomonRemainder(block, divisor)
+		if i < numShortBlocks {
+			block = append(block, 0)
+		}
+		blocks[i] = append(block, ecc...)
+	}
+
+	result := make([]byte, 0, rawCodewords)
+	for i := range blocks[0] {
+		for j, block := range blocks {
+			if i != shortBlockLen-blockECCLen || j >= numShortBlocks {
+				result = append(result, block[i])
+			}
+		}
+	}
+	return result
+}
+
+// drawCodewords é o método que desenha os codewords de dados e de correção de erros em zigue-zague, começando pelo canto inferior direito.
+func (code *Code) drawCodewords(data []byte) {
+	i := 0
+	for right := code.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := 0; vert < code.Size; vert++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				y := vert
+				if (right+1)&2 == 0 {
+					y = code.Size - 1 - vert
+				}
+				if !code.isFunc[y][x] && i < len(data)*8 {
+					code.modules[y][x] = bit(int(data[i>>3]), 7-(i&7))
+					i++
+				}
+			}
+		}
+	}
+}
+
+// applyMask é o método que inverte os módulos de dados de acordo com a máscara informada. Aplicar a mesma máscara duas vezes desfaz a máscara.
+func (code *Code) applyMask(mask int) {
+	for y := 0; y < code.Size; y++ {
+		for x := 0; x < code.Size; x++ {
+			var invert bool
+			switch mask {
+			case 0:
+				invert = (x+y)%2 == 0
+			case 1:
+				invert = y%2 == 0
+			case 2:
+				invert = x%3 == 0
+			case 3:
+				invert = (x+y)%3 == 0
+			case 4:
+				invert = (x/3+y/2)%2 == 0
+			case 5:
+				invert = x*y%2+x*y%3 == 0
+			case 6:
+				invert = (x*y%2+x*y%3)%2 == 0
+			case 7:
+				invert = ((x+y)%2+x*y%3)%2 == 0
+			}
+			if invert && !code.isFunc[y][x] {
+				code.modules[y][x] = !code.modules[y][x]
+			}
+		}
+	}
+}
+
+// penalty é o método que calcula a penalidade da máscara aplicada, de acordo com as quatro regras da especificação.
+func (code *Code) penalty() int {
+	result := 0
+	dark := 0
+
+	for i := 0; i < code.Size; i++ {
+		// Regra 1: sequências de 5 ou mais módulos da mesma cor nas linhas e nas colunas.
+		// Regra 3: padrões parecidos com o localizador (1:1:3:1:1) nas linhas e nas colunas.
+		result += code.linePenalty(func(j int) bool { return code.modules[i][j] })
+		result += code.linePenalty(func(j int) bool { return code.modules[j][i] })
+
+		for j := 0; j < code.Size; j++ {
+			if code.modules[i][j] {
+				dark++
+			}
+			// Regra 2: blocos 2x2 da mesma cor.
+			if i+1 < code.Size && j+1 < code.Size {
+				color := code.modules[i][j]
+				if color == code.modules[i][j+1] && color == code.modules[i+1][j] && color == code.modules[i+1][j+1] {
+					result += 3
+				}
+			}
+		}
+	}
+
+	// Regra 4: proporção de módulos escuros distante de 50%.
+	total := code.Size * code.Size
+	k := (abs(dark*20-total*10)+total-1)/total - 1
+	return result + k*10
+}
+
+// linePenalty é o método que calcula as penalidades das regras 1 e 3 de uma linha ou coluna.
+func (code *Code) linePenalty(module func(int) bool) int {
+	result := 0
+	run := 1
+	for j := 1; j <= code.Size; j++ {
+		if j < code.Size && module(j) == module(j-1) {
+			run++
+			continue
+		}
+		if run >= 5 {
+			result += 3 + run - 5
+		}
+		run = 1
+	}
+
+	pattern := []bool{true, false, true, true, true, false, true}
+	for j := 0; j+7 <= code.Size; j++ {
+		match := true
+		for k, dark := range pattern {
+			if module(j+k) != dark {
+				match = false
+				break
+			}
+		}
+		if match && (lightRun(module, j-4, j, code.Size) || lightRun(module, j+7, j+11, code.Size)) {
+			result += 40
+		}
+	}
+	return result
+}
+
+// lightRun é a função que indica se todos os módulos entre from e to são claros, considerando a margem ao redor do QR Code como clara.
+func lightRun(module func(int) bool, from, to, size int) bool {
+	for j := from; j < to; j++ {
+		if j >= 0 && j < size && module(j) {
+			return false
+		}
+	}
+	return true
+}
+
+// alignmentPatternPositions é a função que retorna as coordenadas dos centros dos padrões de alinhamento de uma versão.
+func alignmentPatternPositions(version int) []int {
+	if version == 1 {
+		return nil
+	}
+	numAlign := version/7 + 2
+	step := (version*8 + numAlign*3 + 5) / (numAlign*4 - 4) * 2
+	result := make([]int, numAlign)
+	result[0] = 6
+	for i, pos := numAlign-1, version*4+17-7; i >= 1; i, pos = i-1, pos-step {
+		result[i] = pos
+	}
+	return result
+}
+
+// numRawDataModules é a função que retorna o número de módulos disponíveis para dados e correção de erros em uma versão.
+func numRawDataModules(version int) int {
+	result := (16*version+128)*version + 64
+	if version >= 2 {
+		numAlign := version/7 + 2
+		result -= (25*numAlign-10)*numAlign - 55
+		if version >= 7 {
+			result -= 36
+		}
+	}
+	return result
+}
+
+// numDataCodewords é a função que retorna o número de codewords de dados de uma versão em um nível de correção.
+func numDataCodewords(version int, level Level) int {
+	return numRawDataModules(version)/8 - eccCodewordsPerBlock[level][version]*numErrorCorrectionBlocks[level][version]
+}
+
+// charCountBits é a função que retorna o tamanho do campo de quantidade de bytes no modo byte.
+func charCountBits(version int) int {
+	if version <= 9 {
+		return 8
+	}
+	return 16
+}
+
+// dataBits é a função que retorna o número de bits necessários para codificar length bytes no modo byte.
+func dataBits(version, length int) int {
+	if length >= 1<<uint(charCountBits(version)) {
+		return 1 << 30
+	}
+	return 4 + charCountBits(version) + length*8
+}
+
+// reedSolomonDivisor é a função que calcula o polinômio gerador de Reed-Solomon do grau informado.
+func reedSolomonDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+	root := byte(1)
+	for i := 0; i < degree; i++ {
+		for j := range result {
+			result[j] = gfMultiply(result[j], root)
+			if j+1 < len(result) {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = gfMultiply(root, 0x02)
+	}
+	return result
+}
+
+// reedSolomonRemainder é a função que calcula os codewords de correção de erros dos dados.
+func reedSolomonRemainder(data, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i, coefficient := range divisor {
+			result[i] ^= gfMultiply(coefficient, factor)
+		}
+	}
+	return result
+}
+
+// gfMultiply é a função que multiplica dois elementos do corpo finito GF(2^8) usado pelo QR Code (polinômio 0x11D).
+func gfMultiply(x, y byte) byte {
+	z := 0
+	for i := 7; i >= 0; i-- {
+		z = (z << 1) ^ ((z >> 7) * 0x11D)
+		z ^= int((y>>uint(i))&1) * int(x)
+	}
+	return byte(z)
+}
+
+// bitBuffer é a struct usada para montar a sequência de bits dos dados.
+type bitBuffer struct {
+	bits []bool
+}
+
+// append é o método que adiciona os length bits menos significativos de value.
+func (buffer *bitBuffer) append(value, length int) {
+	for i := length - 1; i >= 0; i-- {
+		buffer.bits = append(buffer.bits, bit(value, i))
+	}
+}
+
+// len é o método que retorna o número de bits do buffer.
+func (buffer *bitBuffer) len() int {
+	return len(buffer.bits)
+}
+
+// bytes é o método que converte os bits do buffer em bytes.
+func (buffer *bitBuffer) bytes() []byte {
+	result := make([]byte, len(buffer.bits)/8)
+	for i, set := range buffer.bits {
+		if set {
+			result[i>>3] |= 1 << uint(7-i&7)
+		}
+	}
+	return result
+}
+
+// bit é a função que indica se o bit i de value esta ligado.
+func bit(value, i int) bool {
+	return (value>>uint(i))&1 != 0
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,158 @@
+package qrcode
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+)
+
+// Testando o cálculo dos codewords de correção de erros (exemplo "HELLO WORLD" 1-M da especificação)
+func TestReedSolomon(t *testing.T) {
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	expected := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+
+	if ecc := reedSolomonRemainder(data, reedSolomonDivisor(10)); !bytes.Equal(ecc, expected) {
+		t.Error("Codewords de correção de erros incorretos!")
+		t.Error(ecc)
+	}
+}
+
+// Testando as informações de formato e de versão desenhadas no QR Code
+func TestFormatAndVersion(t *testing.T) {
+	code := newCode(7, LevelM)
+	code.drawFunctionPatterns()
+
+	// Nível M com a máscara 0 é 101010000010010 e a versão 7 é 000111110010010100 (tabelas da especificação)
+	format := 0
+	for i := 0; i <= 5; i++ {
+		format |= boolToInt(code.modules[i][8]) << uint(i)
+	}
+	format |= boolToInt(code.modules[7][8])<<6 | boolToInt(code.modules[8][8])<<7 | boolToInt(code.modules[8][7])<<8
+	for i := 9; i < 15; i++ {
+		format |= boolToInt(code.modules[8][14-i]) << uint(i)
+	}
+	if format != 0x5412 {
+		t.Errorf("Informações de formato incorretas: %015b", format)
+	}
+
+	version := 0
+	for i := 0; i < 18; i++ {
+		version |= boolToInt(code.modules[i/3][code.Size-11+i%3]) << uint(i)
+	}
+	if version != 0x07C94 {
+		t.Errorf("Informações de versão incorretas: %018b", version)
+	}
+}
+
+// Testando a geração de um QR Code com um código Pix Copia-e-Cola
+func TestEncode(t *testing.T) {
+	payload := "00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63041D3D"
+
+	code, err := Encode([]byte(payload), LevelM)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if code.Version != 8 || code.Size != 49 {
+		t.Errorf("Versão incorreta: %d", code.Version)
+	}
+
+	// Padrões localizadores e módulo escuro fixo
+	for _, corner := range [][2]int{{0, 0}, {code.Size - 7, 0}, {0, code.Size - 7}} {
+		if !code.Dark(corner[0], corner[1]) || !code.Dark(corner[0]+3, corner[1]+3) || code.Dark(corner[0]+1, corner[1]+1) {
+			t.Error("Padrão localizador incorreto!")
+		}
+	}
+	if !code.Dark(8, code.Size-8) {
+		t.Error("Módulo escuro fixo não encontrado!")
+	}
+
+	// Lendo os codewords de volta da matriz e conferindo os dados
+	mask := readMask(code)
+	code.applyMask(mask)
+	codewords := readCodewords(code)
+	code.applyMask(mask)
+
+	// Versão 8-M: 2 blocos com 38 codewords de dados seguidos de 2 blocos com 39 codewords de dados
+	blocks := make([][]byte, 4)
+	for i, k := 0, 0; i < 39; i++ {
+		for block := range blocks {
+			if i < 38 || block >= 2 {
+				blocks[block] = append(blocks[block], codewords[k])
+				k++
+			}
+		}
+	}
+	data := bytes.Join(blocks, nil)
+
+	// Modo byte (0100) + quantidade (8 bits) + dados
+	decoded := make([]byte, 0, len(payload))
+	length := int(data[0]&0x0F)<<4 | int(data[1]>>4)
+	for i := 0; i < length; i++ {
+		decoded = append(decoded, data[i+1]<<4|data[i+2]>>4)
+	}
+	if data[0]>>4 != 0x4 || string(decoded) != payload {
+		t.Error("Dados lidos do QR Code incorretos: " + string(decoded))
+	}
+
+	image, err := code.PNG(300)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decodedImage, err := png.Decode(bytes.NewReader(image))
+	if err != nil || decodedImage.Bounds().Dx() != 285 {
+		t.Error("Imagem gerada incorretamente!")
+	}
+
+	if _, err := Encode(make([]byte, 3000), LevelM); err != ErrDataTooLong {
+		t.Error("Era esperado erro de dados muito longos!")
+	}
+}
+
+// readMask é a função que lê a máscara das informações de formato do QR Code.
+func readMask(code *Code) int {
+	format := 0
+	for i := 0; i <= 5; i++ {
+		format |= boolToInt(code.modules[i][8]) << uint(i)
+	}
+	format |= boolToInt(code.modules[7][8])<<6 | boolToInt(code.modules[8][8])<<7 | boolToInt(code.modules[8][7])<<8
+	for i := 9; i < 15; i++ {
+		format |= boolToInt(code.modules[8][14-i]) << uint(i)
+	}
+	return (format ^ 0x5412) >> 10 & 7
+}
+
+// readCodewords é a função que lê os codewords do QR Code na mesma ordem em que eles são desenhados.
+func readCodewords(code *Code) []byte {
+	var result []byte
+	var current byte
+	count := 0
+	for right := code.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := 0; vert < code.Size; vert++ {
+			for j := 0; j < 2; j++ {
+				x, y := right-j, vert
+				if (right+1)&2 == 0 {
+					y = code.Size - 1 - vert
+				}
+				if code.isFunc[y][x] {
+					continue
+				}
+				current = current<<1 | byte(boolToInt(code.modules[y][x]))
+				if count++; count%8 == 0 {
+					result = append(result, current)
+				}
+			}
+		}
+	}
+	return result
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
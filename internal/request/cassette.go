@@ -0,0 +1,442 @@
+package request
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Mode Modo de funcionamento do Recorder
+type Mode int
+
+const (
+	ModeRecord Mode = iota // Envia as requisições para a API e grava as interações no cassete
+	ModeReplay             // Reproduz as interações gravadas no cassete sem acessar a API
+	ModeAuto               // Reproduz o cassete caso o arquivo exista, caso contrário grava um novo cassete
+)
+
+// Redacted Valor que substitui as informações sensíveis gravadas no cassete
+const Redacted = "REDACTED"
+
+// RedactedEmail Email que substitui os emails gravados no cassete
+const RedactedEmail = "redacted@example.com"
+
+// ErrNoInteraction Erro retornado no modo ModeReplay quando nenhuma interação do cassete corresponde à requisição
+var ErrNoInteraction = errors.New("request: nenhuma interação do cassete corresponde à requisição")
+
+// Cassette Interações HTTP gravadas em um arquivo JSON
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction Requisição e resposta gravadas no cassete
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest Requisição gravada no cassete, com as informações sensíveis removidas
+type RecordedRequest struct {
+	Method  string            `json:"method"`
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    string            `json:"body,omitempty"`
+}
+
+// RecordedResponse Resposta gravada no cassete, com as informações sensíveis removidas
+type RecordedResponse struct {
+	StatusCode int               `json:"status_code"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       string            `json:"body,omitempty"`
+}
+
+// Recorder Transporte HTTP que grava as interações com a API em um cassete e depois as reproduz de forma determinística.
+// No modo ModeReplay as requisições são comparadas pelo método, caminho, query e body (JSON normalizado), ignorando o host e os headers,
+// e cada interação é usada somente uma vez, na ordem em que foi gravada.
+type Recorder struct {
+	Path      string            // Caminho do arquivo do cassete
+	Mode      Mode              // Modo de funcionamento (ModeAuto é resolvido para ModeRecord ou ModeReplay no NewRecorder)
+	Transport http.RoundTripper // Transporte usado no modo ModeRecord, quando nil é usado o http.DefaultTransport
+
+	mutex    sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// NewRecorder Cria um Recorder para o cassete informado. No modo ModeReplay (ou ModeAuto com o arquivo existente) o cassete é carregado.
+func NewRecorder(path string, mode Mode) (*Recorder, error) {
+	if mode == ModeAuto {
+		mode = ModeRecord
+		if _, err := os.Stat(path); err == nil {
+			mode = ModeReplay
+		}
+	}
+
+	recorder := &Recorder{Path: path, Mode: mode}
+	if mode != ModeReplay {
+		return recorder, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &recorder.cassette); err != nil {
+		return nil, fmt.Errorf("request: cassete %s inválido: %w", path, err)
+	}
+	recorder.used = make([]bool, len(recorder.cassette.Interactions))
+	return recorder, nil
+}
+
+// RoundTrip Executa a requisição de acordo com o modo do Recorder (gravando ou reproduzindo a interação)
+func (recorder *Recorder) RoundTrip(request *http.Request) (*http.Response, error) {
+	var body []byte
+	if request.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(request.Body); err != nil {
+			return nil, err
+		}
+		request.Body.Close()
+		request.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	recorded := RecordedRequest{
+		Method:  request.Method,
+		URL:     redactURL(request.URL),
+		Headers: redactHeaders(request.Header),
+		Body:    redactBody(body),
+	}
+
+	if recorder.Mode == ModeReplay {
+		return recorder.replay(request, recorded)
+	}
+	return recorder.record(request, recorded)
+}
+
+// Stop Grava o cassete no arquivo (somente no modo ModeRecord). Os diretórios do caminho são criados caso não existam.
+func (recorder *Recorder) Stop() error {
+	if recorder.Mode != ModeRecord {
+		return nil
+	}
+
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	data, err := json.MarshalIndent(recorder.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(recorder.Path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(recorder.Path, append(data, '\n'), 0644)
+}
+
+// Unused Retorna as interações do cassete que não foram reproduzidas (somente no modo ModeReplay)
+func (recorder *Recorder) Unused() []Interaction {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	var interactions []Interaction
+	for i, used := range recorder.used {
+		if !used {
+			interactions = append(interactions, recorder.cassette.Interactions[i])
+		}
+	}
+	return interactions
+}
+
+// record Envia a requisição para a API e grava a interação com as informações sensíveis removidas
+func (recorder *Recorder) record(request *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	transport := recorder.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	response, err := transport.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	headers := redactHeaders(response.Header)
+	delete(headers, "Set-Cookie")
+
+	recorder.mutex.Lock()
+	recorder.cassette.Interactions = append(recorder.cassette.Interactions, Interaction{
+		Request:  recorded,
+		Response: RecordedResponse{StatusCode: response.StatusCode, Headers: headers, Body: redactBody(body)},
+	})
+	recorder.mutex.Unlock()
+
+	return response, nil
+}
+
+// replay Retorna a resposta da primeira interação ainda não usada que corresponde à requisição
+func (recorder *Recorder) replay(request *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	for i, interaction := range recorder.cassette.Interactions {
+		if recorder.used[i] || !matchRequest(interaction.Request, recorded) {
+			continue
+		}
+		recorder.used[i] = true
+
+		header := http.Header{}
+		for name, value := range interaction.Response.Headers {
+			header.Set(name, value)
+		}
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          ioutil.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       request,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s %s (cassete %s)", ErrNoInteraction, recorded.Method, recorded.URL, recorder.Path)
+}
+
+// matchRequest Compara o método, o caminho, a query e o body (JSON normalizado) da requisição com a requisição gravada
+func matchRequest(recorded, request RecordedRequest) bool {
+	if recorded.Method != request.Method {
+		return false
+	}
+
+	recordedURL, err := url.Parse(recorded.URL)
+	if err != nil {
+		return false
+	}
+	requestURL, err := url.Parse(request.URL)
+	if err != nil {
+		return false
+	}
+	if recordedURL.Path != requestURL.Path || recordedURL.Query().Encode() != requestURL.Query().Encode() {
+		return false
+	}
+
+	return normalizeBody(recorded.Body) == normalizeBody(request.Body)
+}
+
+// normalizeBody Retorna o JSON do body com as chaves ordenadas e sem espaços, para que a formatação não interfira na comparação.
+// Os números são mantidos como foram escritos, para que IDs maiores que 2^53 não sejam considerados iguais.
+func normalizeBody(body string) string {
+	var value interface{}
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return strings.TrimSpace(body)
+	}
+	data, _ := json.Marshal(value)
+	return string(data)
+}
+
+///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Expressões usadas para encontrar os access tokens e emails nos textos gravados
+var (
+	tokenRegexp = regexp.MustCompile(`(APP_USR|TEST)-[0-9A-Za-z-]{16,}`)
+	emailRegexp = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+)
+
+// sensitiveHeaders Headers que possuem credenciais e são sempre removidos
+var sensitiveHeaders = map[string]bool{"Authorization": true, "Cookie": true, "Set-Cookie": true}
+
+// sensitiveKeys Campos do JSON que possuem credenciais ou dados pessoais e são sempre removidos
+var sensitiveKeys = map[string]bool{
+	"access_token":  true,
+	"refresh_token": true,
+	"public_key":    true,
+	"client_secret": true,
+	"token":         true,
+	"password":      true,
+	"doc_number":    true,
+}
+
+// redactText Remove os access tokens e os emails de um texto
+func redactText(text string) string {
+	text = tokenRegexp.ReplaceAllString(text, Redacted)
+	return emailRegexp.ReplaceAllString(text, RedactedEmail)
+}
+
+// redactURL Remove os access tokens e emails do caminho e da query da URL
+func redactURL(requestURL *url.URL) string {
+	query := requestURL.Query()
+	for key, values := range query {
+		for i, value := range values {
+			if sensitiveKeys[key] {
+				values[i] = Redacted
+			} else {
+				values[i] = redactText(value)
+			}
+		}
+	}
+
+	redacted := url.URL{Scheme: requestURL.Scheme, Host: requestURL.Host, Path: redactText(requestURL.Path), RawQuery: query.Encode()}
+	return redacted.String()
+}
+
+// redactHeaders Retorna o primeiro valor de cada header, removendo os headers com credenciais
+func redactHeaders(header http.Header) map[string]string {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	headers := map[string]string{}
+	for _, name := range names {
+		if sensitiveHeaders[http.CanonicalHeaderKey(name)] {
+			headers[name] = Redacted
+		} else {
+			headers[name] = redactText(header.Get(name))
+		}
+	}
+	return headers
+}
+
+// redactBody Remove as credenciais, os emails e os números dos documentos de identificação do body.
+// Quando o body não é um JSON somente os access tokens e os emails são removidos.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	redacted, err := redactJSON(body)
+	if err != nil {
+		return redactText(string(body))
+	}
+	return redacted
+}
+
+// redactFrame Objeto ou array do JSON que esta sendo percorrido pelo redactJSON
+type redactFrame struct {
+	object    bool   // Indica se é um objeto ou um array
+	name      string // Chave do campo que contém o objeto ou o array (os itens de um array herdam a chave do array)
+	key       string // Última chave lida do objeto
+	expectKey bool   // Indica se o próximo token do objeto é uma chave
+	count     int    // Quantidade de campos ou itens já escritos
+}
+
+// redactJSON Percorre o JSON token por token removendo os campos sensíveis, sem alterar a ordem das chaves, os números (que não passam pelo float64)
+// e sem escapar os caracteres HTML. O campo number só é removido dentro de identification, pois em outros objetos não é um documento.
+func redactJSON(body []byte) (string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var buffer bytes.Buffer
+	var stack []*redactFrame
+	started := false
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+
+		var top *redactFrame
+		if len(stack) > 0 {
+			top = stack[len(stack)-1]
+		} else if started {
+			return "", errors.New("request: o body possui mais de um valor JSON")
+		}
+
+		if delim, ok := token.(json.Delim); ok && (delim == '}' || delim == ']') {
+			buffer.WriteByte(byte(delim))
+			stack = stack[:len(stack)-1]
+			if len(stack) > 0 && stack[len(stack)-1].object {
+				stack[len(stack)-1].expectKey = true
+			}
+			continue
+		}
+
+		if top != nil && top.object && top.expectKey {
+			if top.count > 0 {
+				buffer.WriteByte(',')
+			}
+			top.count++
+			top.key, top.expectKey = token.(string), false
+			writeJSONString(&buffer, top.key)
+			buffer.WriteByte(':')
+			continue
+		}
+
+		name := ""
+		if top != nil && top.object {
+			name = top.key
+		} else if top != nil {
+			name = top.name
+			if top.count > 0 {
+				buffer.WriteByte(',')
+			}
+			top.count++
+		}
+		started = true
+
+		switch value := token.(type) {
+		case json.Delim:
+			buffer.WriteByte(byte(value))
+			stack = append(stack, &redactFrame{object: value == '{', name: name, expectKey: true})
+			continue
+		case string:
+			if top != nil && top.object && (sensitiveKeys[name] || (name == "number" && top.name == "identification")) {
+				value = Redacted
+			} else {
+				value = redactText(value)
+			}
+			writeJSONString(&buffer, value)
+		case json.Number:
+			buffer.WriteString(value.String())
+		case bool:
+			buffer.WriteString(strconv.FormatBool(value))
+		case nil:
+			buffer.WriteString("null")
+		}
+
+		if top != nil && top.object {
+			top.expectKey = true
+		}
+	}
+
+	if !started {
+		return "", io.ErrUnexpectedEOF
+	}
+	return buffer.String(), nil
+}
+
+// writeJSONString Escreve uma string no formato JSON sem escapar os caracteres HTML (<, > e &)
+func writeJSONString(buffer *bytes.Buffer, value string) {
+	var encoded bytes.Buffer
+	encoder := json.NewEncoder(&encoded)
+	encoder.SetEscapeHTML(false)
+	encoder.Encode(value)
+	buffer.Write(bytes.TrimSuffix(encoded.Bytes(), []byte("\n")))
+}
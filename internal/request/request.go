@@ -2,9 +2,11 @@ package request
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -13,6 +15,10 @@ import (
 	"time"
 )
 
+// Transport Transporte HTTP usado por todas as requisições, quando nil é usado o http.DefaultTransport.
+// Pode ser substituido para interceptar as requisições, como o Recorder faz para gravar e reproduzir cassetes.
+var Transport http.RoundTripper
+
 type Headers map[string]interface{}
 type QueryParams map[string]interface{}
 type PathParams []interface{}
@@ -28,6 +34,7 @@ type Params struct {
 	QueryParams  QueryParams
 	BasicAuth    *BasicAuth
 	HandleErrors *bool
+	Context      context.Context
 }
 
 // BasicAuth Usuário e senha usados na autenticação por BasicAuth
@@ -46,6 +53,47 @@ type Response struct {
 
 // New Efetua uma requsição http para uma API, microservice ou outro.
 func New(params Params) (*Response, error) {
+
+	// Verificando se algum timeout foi passado por parametro, caso não tenha sido passado então setamos 40 por padrão.
+	if params.Timeout == 0 {
+		params.Timeout = 40
+	}
+
+	// Executando a requisição.
+	res, err := do(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	return readResponse(res)
+}
+
+// Stream Efetua uma requsição http sem ler o body da resposta, usado para baixar arquivos grandes (exemplo: relatórios em CSV) sem carregar tudo em memória.
+// Quando o StatusCode é de sucesso (code <= 300) o body é retornado aberto e deve ser fechado por quem chamou, caso contrario o body é lido e decodado
+// da mesma forma que no método New. Diferente do New, quando nenhum timeout é passado por parametro a requisição não possui timeout, então a requisição
+// e a leitura do body devem ser canceladas pelo Context dos parametros.
+func Stream(params Params) (*Response, io.ReadCloser, error) {
+	res, err := do(params)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if res.StatusCode <= 300 {
+		return &Response{StatusCode: res.StatusCode, Headers: readHeaders(res), Body: map[string]interface{}{}, RawBody: []byte{}}, res.Body, nil
+	}
+
+	defer res.Body.Close()
+
+	response, err := readResponse(res)
+	return response, nil, err
+}
+
+// do Monta e executa a requisição http, retornando a resposta sem ler o body.
+func do(params Params) (*http.Response, error) {
 	var body *bytes.Reader
 
 	// Verificando caso a requisição possua body então encodamos ele em JSON
@@ -83,13 +131,19 @@ func New(params Params) (*Response, error) {
 	var request *http.Request
 	var err error
 
+	// Verificando se algum Context foi passado por parametro, caso não tenha sido passado então a requisição não pode ser cancelada.
+	ctx := params.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
 	// Instanciando a requisição para depois o cliente pode executa-la.
 	// Caso a requisição possua body então passamos a variavel do body, caso contrario passamos nil.
 	// Não podemos passar a variavel body quando ela é nil porque se não da erro interno do GO por causa dos nils tipados e nils não tipados.
 	if body == nil {
-		request, err = http.NewRequest(params.Method, params.URL, nil)
+		request, err = http.NewRequestWithContext(ctx, params.Method, params.URL, nil)
 	} else {
-		request, err = http.NewRequest(params.Method, params.URL, body)
+		request, err = http.NewRequestWithContext(ctx, params.Method, params.URL, body)
 	}
 
 	if err != nil {
@@ -114,30 +168,28 @@ func New(params Params) (*Response, error) {
 	}
 
 	// Instanciando o client que ira executar a requsição.
-	client := &http.Client{}
-
-	// Verificando se algum timeout foi passado por parametro, caso não tenha sido passado então setamos 40 por padrão.
-	if params.Timeout == 0 {
-		params.Timeout = 40
-	}
+	client := &http.Client{Transport: Transport}
 
 	// Setando o timeout no client.
 	client.Timeout = time.Duration(params.Timeout) * time.Second
 
-	// Executando a requisição.
-	res, err := client.Do(request)
-
-	if err != nil {
-		return nil, err
-	}
-
-	defer res.Body.Close()
+	return client.Do(request)
+}
 
-	// Lendo os headers da resposta que veio da API.
+// readHeaders Lê os headers da resposta que veio da API.
+func readHeaders(res *http.Response) Headers {
 	headers := Headers{}
 	for name, values := range res.Header {
 		headers[name] = values[0]
 	}
+	return headers
+}
+
+// readResponse Lê e decoda o body da resposta que veio da API.
+func readResponse(res *http.Response) (*Response, error) {
+
+	// Lendo os headers da resposta que veio da API.
+	headers := readHeaders(res)
 
 	// Lendo a resposta veio da API.
 	rawBody, err := ioutil.ReadAll(res.Body)
@@ -0,0 +1,136 @@
+package mercadopagotest
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// accountRoute é o método que trata as requisições do saldo (/users/{id}/mercadopago_account/balance) e das movimentações
+// (/mercadopago_account/movements/search) da conta. O saldo e as movimentações são calculados a partir dos pagamentos salvos no servidor.
+func (server *Server) accountRoute(method string, segments []string, query url.Values) (int, interface{}) {
+	switch {
+	case len(segments) == 4 && segments[0] == "users" && segments[2] == "mercadopago_account" && segments[3] == "balance" && method == http.MethodGet:
+		if segments[1] != strconv.FormatInt(server.CollectorID, 10) {
+			return http.StatusForbidden, errorBody(http.StatusForbidden, "forbidden", "access denied to user "+segments[1])
+		}
+		return http.StatusOK, server.balance()
+
+	case len(segments) == 3 && segments[0] == "mercadopago_account" && segments[2] == "search" && method == http.MethodGet:
+		if query.Get("user_id") == "" {
+			return http.StatusBadRequest, errorBody(http.StatusBadRequest, "bad_request", "user_id is required")
+		}
+		if query.Get("user_id") != strconv.FormatInt(server.CollectorID, 10) {
+			return http.StatusForbidden, errorBody(http.StatusForbidden, "forbidden", "access denied to user "+query.Get("user_id"))
+		}
+
+		results := []interface{}{}
+		for _, movement := range server.movements() {
+			if matchQuery(movement, query) {
+				results = append(results, movement)
+			}
+		}
+
+		offset, limit := pagination(query)
+		return http.StatusOK, Object{
+			"paging":  Object{"total": len(results), "limit": limit, "offset": offset},
+			"results": paginate(results, offset, limit),
+		}
+	}
+
+	return http.StatusNotFound, errorBody(http.StatusNotFound, "not_found", "resource not found")
+}
+
+// balance é o método que calcula o saldo da conta somando as movimentações, o dinheiro dos pagamentos em disputa fica indisponível.
+func (server *Server) balance() Object {
+	available, unavailable := int64(0), int64(0)
+	for _, movement := range server.movements() {
+		if movement["status"] == "available" {
+			available += cents(movement["amount"])
+		} else {
+			unavailable += cents(movement["amount"])
+		}
+	}
+
+	return Object{
+		"user_id":                               server.CollectorID,
+		"currency_id":                           "BRL",
+		"total_amount":                          amount(available + unavailable),
+		"available_balance":                     amount(available),
+		"unavailable_balance":                   amount(unavailable),
+		"unavailable_balance_by_reason":         []Object{{"reason": "dispute", "amount": amount(unavailable)}},
+		"available_balance_by_transaction_type": []Object{{"transaction_type": "payment", "amount": amount(available)}},
+	}
+}
+
+// movements é o método que monta as movimentações da conta a partir dos pagamentos recebidos, do mais antigo para o mais recente:
+// a entrada do pagamento, a saída da tarifa do MercadoPago, a saída do valor reembolsado e a saída do estorno.
+func (server *Server) movements() []Object {
+	payments := []interface{}{}
+	for _, payment := range server.payments {
+		payments = append(payments, payment)
+	}
+	sortByDateCreated(payments)
+
+	var movements []Object
+	balanced := int64(0)
+	add := func(payment Object, sequence int64, detail string, value int64, status string) {
+		balanced += value
+		movementType := "income"
+		if value < 0 {
+			movementType = "expense"
+		}
+		movements = append(movements, Object{
+			"id":               int64(number(payment["id"]))*10 + sequence,
+			"user_id":          server.CollectorID,
+			"type":             movementType,
+			"detail":           detail,
+			"status":           status,
+			"amount":           amount(value),
+			"balanced_amount":  amount(balanced),
+			"currency_id":      payment["currency_id"],
+			"reference_id":     payment["id"],
+			"financial_entity": "payment",
+			"site_id":          "MLB",
+			"date_created":     payment["date_created"],
+			"date_released":    payment["date_created"],
+		})
+	}
+
+	for _, element := range payments {
+		payment := element.(Object)
+
+		status := toString(payment["status"])
+		switch status {
+		case "approved", "authorized", "in_mediation", "refunded", "charged_back":
+		default:
+			continue
+		}
+
+		moneyStatus := "available"
+		if status == "in_mediation" {
+			moneyStatus = "unavailable"
+		}
+
+		fee := int64(0)
+		if feeDetails, ok := payment["fee_details"].([]interface{}); ok {
+			for _, feeDetail := range feeDetails {
+				if feeDetail, ok := feeDetail.(map[string]interface{}); ok {
+					fee += cents(feeDetail["amount"])
+				}
+			}
+		}
+
+		add(payment, 1, "payment", cents(payment["transaction_amount"]), moneyStatus)
+		if fee > 0 {
+			add(payment, 2, "fee", -fee, moneyStatus)
+		}
+		if refunded := cents(payment["transaction_amount_refunded"]); refunded > 0 {
+			add(payment, 3, "refund", -refunded, "available")
+		}
+		if status == "charged_back" {
+			add(payment, 4, "chargeback", -cents(payment["transaction_amount"]), "available")
+		}
+	}
+	return movements
+}
@@ -0,0 +1,59 @@
+package mercadopagotest
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/eduardo-mior/mercadopago-sdk-go/internal/request"
+)
+
+// CassetteMode é o modo de funcionamento de um cassete.
+type CassetteMode int
+
+const (
+	CassetteRecord CassetteMode = CassetteMode(request.ModeRecord) // Envia as requisições para o MercadoPago (sandbox) e grava as interações no cassete
+	CassetteReplay CassetteMode = CassetteMode(request.ModeReplay) // Reproduz as interações gravadas sem acessar a internet
+	CassetteAuto   CassetteMode = CassetteMode(request.ModeAuto)   // Reproduz o cassete caso o arquivo exista, caso contrário grava um novo cassete
+)
+
+// CassettesDir é o diretório padrão dos cassetes usados pela função Cassette.
+const CassettesDir = "testdata/cassettes"
+
+// ErrNoInteraction é o erro retornado pelo SDK no modo de reprodução quando nenhuma interação gravada corresponde à requisição.
+// As requisições são comparadas pelo método, caminho, query e body, o erro contém o método e a URL da requisição que não foi encontrada.
+var ErrNoInteraction = request.ErrNoInteraction
+
+// UseCassette é a função que faz todas as requisições do SDK passarem pelo cassete informado, gravando as interações reais com o MercadoPago
+// ou reproduzindo as interações gravadas de forma determinística. Os cassetes são arquivos JSON e antes de serem gravados têm removidos os
+// headers Authorization, os access tokens, os emails e os números dos documentos de identificação.
+// Retorna a função que deve ser chamada no final do teste, ela grava o cassete (no modo de gravação) e restaura o transporte padrão do SDK.
+func UseCassette(path string, mode CassetteMode) (func() error, error) {
+	recorder, err := request.NewRecorder(path, request.Mode(mode))
+	if err != nil {
+		return nil, err
+	}
+
+	previous := request.Transport
+	request.Transport = recorder
+	return func() error {
+		request.Transport = previous
+		return recorder.Stop()
+	}, nil
+}
+
+// Cassette é a função auxiliar dos testes que usa o cassete testdata/cassettes/{name}.json no modo CassetteAuto.
+// Na primeira execução o cassete é gravado e nas próximas ele é reproduzido, para gravar novamente basta apagar o arquivo.
+// O cassete é finalizado automaticamente no final do teste.
+func Cassette(t testing.TB, name string) {
+	t.Helper()
+
+	stop, err := UseCassette(filepath.Join(CassettesDir, name+".json"), CassetteAuto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := stop(); err != nil {
+			t.Error(err)
+		}
+	})
+}
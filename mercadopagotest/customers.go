@@ -0,0 +1,103 @@
+package mercadopagotest
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Customer é o método que retorna uma cópia do cliente salvo no servidor.
+func (server *Server) Customer(id string) (Object, bool) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	customer, ok := server.customers[id]
+	if !ok {
+		return nil, false
+	}
+	return copyObject(customer), true
+}
+
+// customersRoute é o método que trata as requisições do recurso /v1/customers.
+func (server *Server) customersRoute(method string, segments []string, query url.Values, data Object) (int, interface{}) {
+	switch {
+	case len(segments) == 0 && method == http.MethodPost:
+		email, _ := data["email"].(string)
+		if !strings.Contains(email, "@") {
+			return http.StatusBadRequest, errorBody(http.StatusBadRequest, "bad_request", "invalid email", Object{"code": "100", "description": "the attribute email is required"})
+		}
+		if server.customerByEmail(email) != nil {
+			return http.StatusBadRequest, errorBody(http.StatusBadRequest, "bad_request", "the customer already exist", Object{"code": "101", "description": "the customer already exist"})
+		}
+
+		id := strconv.FormatInt(server.nextCustomerID, 10) + "-" + strings.ReplaceAll(newUUID(), "-", "")[:14]
+		server.nextCustomerID++
+
+		customer := Object{}
+		for key, value := range data {
+			customer[key] = value
+		}
+		customer["id"] = id
+		customer["date_created"] = server.now()
+		customer["date_last_updated"] = customer["date_created"]
+		customer["live_mode"] = false
+		customer["cards"] = []interface{}{}
+		customer["addresses"] = []interface{}{}
+		server.customers[id] = customer
+		return http.StatusCreated, customer
+
+	case len(segments) == 1 && segments[0] == "search" && method == http.MethodGet:
+		results := []interface{}{}
+		for _, customer := range server.customers {
+			if matchQuery(customer, query) {
+				results = append(results, customer)
+			}
+		}
+		sortByDateCreated(results)
+		offset, limit := pagination(query)
+		return http.StatusOK, Object{
+			"paging":  Object{"total": len(results), "limit": limit, "offset": offset},
+			"results": paginate(results, offset, limit),
+		}
+	}
+
+	if len(segments) != 1 {
+		return http.StatusMethodNotAllowed, errorBody(http.StatusMethodNotAllowed, "method_not_allowed", "method "+method+" not allowed")
+	}
+
+	customer, ok := server.customers[segments[0]]
+	if !ok {
+		return http.StatusNotFound, errorBody(http.StatusNotFound, "not_found", "customer not found", Object{"code": "not_found", "description": "customer not found"})
+	}
+
+	switch method {
+	case http.MethodGet:
+		return http.StatusOK, customer
+
+	case http.MethodPut:
+		if email, ok := data["email"].(string); ok && email != customer["email"] {
+			if other := server.customerByEmail(email); other != nil {
+				return http.StatusBadRequest, errorBody(http.StatusBadRequest, "bad_request", "the customer already exist", Object{"code": "101", "description": "the customer already exist"})
+			}
+		}
+		for key, value := range data {
+			if key != "id" && key != "date_created" {
+				customer[key] = value
+			}
+		}
+		customer["date_last_updated"] = server.now()
+		return http.StatusOK, customer
+	}
+
+	return http.StatusMethodNotAllowed, errorBody(http.StatusMethodNotAllowed, "method_not_allowed", "method "+method+" not allowed")
+}
+
+// customerByEmail é o método que retorna o cliente com o email informado, sem diferenciar maiúsculas e minúsculas.
+func (server *Server) customerByEmail(email string) Object {
+	for _, customer := range server.customers {
+		if strings.EqualFold(toString(customer["email"]), email) {
+			return customer
+		}
+	}
+	return nil
+}
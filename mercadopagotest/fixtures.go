@@ -0,0 +1,55 @@
+package mercadopagotest
+
+// identificationTypes são os tipos de documento de identificação retornados pelo servidor, os mesmos retornados pelo MercadoPago no Brasil.
+var identificationTypes = []Object{
+	{"id": "CPF", "name": "CPF", "type": "number", "min_length": 11, "max_length": 11},
+	{"id": "CNPJ", "name": "CNPJ", "type": "number", "min_length": 14, "max_length": 14},
+}
+
+// paymentMethods são os métodos de pagamento retornados pelo servidor, um resumo dos métodos retornados pelo MercadoPago no Brasil.
+var paymentMethods = []Object{
+	cardPaymentMethod("visa", "Visa", "credit_card", "^4"),
+	cardPaymentMethod("master", "Mastercard", "credit_card", "^(5[1-5]|2[2-7])"),
+	cardPaymentMethod("elo", "Elo", "credit_card", "^(401178|438935|451416|457631|504175|506699|5067|509|627780|636297|636368|650|6516|6550)"),
+	cardPaymentMethod("amex", "American Express", "credit_card", "^(34|37)"),
+	cardPaymentMethod("debelo", "Elo Débito", "debit_card", "^(506722|509023|650485)"),
+	cardPaymentMethod("debmaster", "Mastercard Débito", "debit_card", "^(502121|536106)"),
+	{"id": "pix", "name": "PIX", "payment_type_id": "bank_transfer", "status": "active", "settings": []Object{}, "min_allowed_amount": 0.01, "max_allowed_amount": 10000000, "accreditation_time": 0, "processing_modes": []string{"aggregator"}},
+	{"id": "bolbradesco", "name": "Boleto", "payment_type_id": "ticket", "status": "active", "settings": []Object{}, "min_allowed_amount": 4, "max_allowed_amount": 100000, "accreditation_time": 1440, "processing_modes": []string{"aggregator"}},
+	{"id": "pec", "name": "Pagamento na lotérica sem boleto", "payment_type_id": "ticket", "status": "active", "settings": []Object{}, "min_allowed_amount": 4, "max_allowed_amount": 2000, "accreditation_time": 1440, "processing_modes": []string{"aggregator"}},
+	{"id": "account_money", "name": "Dinheiro na minha conta do MercadoPago", "payment_type_id": "account_money", "status": "active", "settings": []Object{}, "min_allowed_amount": 0.01, "max_allowed_amount": 10000000, "accreditation_time": 0, "processing_modes": []string{"aggregator"}},
+}
+
+// cardPaymentMethod é a função que monta um método de pagamento de cartão com as configurações de bin, número e código de segurança.
+func cardPaymentMethod(id, name, paymentTypeID, pattern string) Object {
+	securityCodeLength := 3
+	if id == "amex" {
+		securityCodeLength = 4
+	}
+	return Object{
+		"id":              id,
+		"name":            name,
+		"payment_type_id": paymentTypeID,
+		"status":          "active",
+		"settings": []Object{{
+			"bin":           Object{"pattern": pattern, "exclusion_pattern": "", "installments_pattern": pattern},
+			"card_number":   Object{"length": 16, "validation": "standard"},
+			"security_code": Object{"mode": "mandatory", "length": securityCodeLength, "card_location": "back"},
+		}},
+		"additional_info_needed": []string{"cardholder_name", "cardholder_identification_type", "cardholder_identification_number"},
+		"min_allowed_amount":     0.5,
+		"max_allowed_amount":     60000,
+		"accreditation_time":     2880,
+		"processing_modes":       []string{"aggregator"},
+	}
+}
+
+// paymentTypeOf é a função que retorna o tipo do método de pagamento (exemplo: credit_card, ticket), ou vazio quando o método não existe.
+func paymentTypeOf(paymentMethodID string) string {
+	for _, paymentMethod := range paymentMethods {
+		if paymentMethod["id"] == paymentMethodID {
+			return paymentMethod["payment_type_id"].(string)
+		}
+	}
+	return ""
+}
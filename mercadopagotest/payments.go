@@ -0,0 +1,378 @@
+package mercadopagotest
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eduardo-mior/mercadopago-sdk-go/boleto"
+	"github.com/eduardo-mior/mercadopago-sdk-go/internal/qrcode"
+	"github.com/eduardo-mior/mercadopago-sdk-go/pix"
+)
+
+// Prazos de expiração padrão dos pagamentos pendentes, usados quando o date_of_expiration não é informado.
+const (
+	PixExpiration    = 24 * time.Hour     // Prazo padrão para pagar um Pix
+	BoletoExpiration = 3 * 24 * time.Hour // Prazo padrão para pagar um boleto ou PEC
+)
+
+// AddPayment é o método que adiciona um pagamento no servidor, útil para preparar o estado antes de um teste.
+// O pagamento pode ser qualquer struct ou map no formato JSON do MercadoPago, caso não possua ID então é gerado um novo ID.
+// Os campos que não forem informados são preenchidos como em um pagamento aprovado. Retorna o ID do pagamento adicionado.
+func (server *Server) AddPayment(payment interface{}) (int64, error) {
+	object, err := toObject(payment)
+	if err != nil {
+		return 0, err
+	}
+
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	id := int64(number(object["id"]))
+	if id == 0 {
+		id = server.nextPaymentID
+		server.nextPaymentID++
+	}
+	object["id"] = id
+	server.fillPayment(object)
+	server.payments[id] = object
+	return id, nil
+}
+
+// Payment é o método que retorna uma cópia do pagamento salvo no servidor.
+func (server *Server) Payment(id int64) (Object, bool) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	payment, ok := server.payments[id]
+	if !ok {
+		return nil, false
+	}
+	return copyObject(payment), true
+}
+
+// paymentsRoute é o método que trata as requisições do recurso /v1/payments e dos reembolsos /v1/payments/{id}/refunds.
+func (server *Server) paymentsRoute(method string, segments []string, query url.Values, data Object, idempotencyKey string) (int, interface{}) {
+	switch {
+	case len(segments) == 0 && method == http.MethodPost:
+		return server.createPayment(data, idempotencyKey)
+
+	case len(segments) == 1 && segments[0] == "search" && method == http.MethodGet:
+		return http.StatusOK, server.searchPayments(query)
+	}
+
+	if len(segments) == 0 {
+		return http.StatusMethodNotAllowed, errorBody(http.StatusMethodNotAllowed, "method_not_allowed", "method "+method+" not allowed")
+	}
+
+	id, _ := strconv.ParseInt(segments[0], 10, 64)
+	payment, ok := server.payments[id]
+	if !ok {
+		return http.StatusNotFound, errorBody(http.StatusNotFound, "not_found", "Payment not found", Object{"code": 2000, "description": "Payment not found"})
+	}
+
+	switch {
+	case len(segments) == 1 && method == http.MethodGet:
+		return http.StatusOK, payment
+
+	case len(segments) == 1 && method == http.MethodPut:
+		return server.updatePayment(payment, data)
+
+	case len(segments) == 2 && segments[1] == "refunds" && method == http.MethodPost:
+		return server.createRefund(payment, data)
+
+	case len(segments) == 2 && segments[1] == "refunds" && method == http.MethodGet:
+		refunds, _ := payment["refunds"].([]interface{})
+		return http.StatusOK, refunds
+
+	case len(segments) == 3 && segments[1] == "refunds" && method == http.MethodGet:
+		refunds, _ := payment["refunds"].([]interface{})
+		for _, refund := range refunds {
+			if toString(refund.(map[string]interface{})["id"]) == segments[2] {
+				return http.StatusOK, refund
+			}
+		}
+		return http.StatusNotFound, errorBody(http.StatusNotFound, "not_found", "Refund not found")
+	}
+
+	return http.StatusMethodNotAllowed, errorBody(http.StatusMethodNotAllowed, "method_not_allowed", "method "+method+" not allowed")
+}
+
+// createPayment é o método que cria um novo pagamento. O X-Idempotency-Key é obrigatório e caso a mesma chave seja enviada novamente
+// então o pagamento criado na primeira requisição é retornado, sem criar um novo pagamento.
+func (server *Server) createPayment(data Object, idempotencyKey string) (int, interface{}) {
+	if idempotencyKey == "" {
+		return http.StatusBadRequest, errorBody(http.StatusBadRequest, "bad_request", "Header X-Idempotency-Key can't be null")
+	}
+	if id, ok := server.idempotency[idempotencyKey]; ok {
+		return http.StatusCreated, server.payments[id]
+	}
+
+	if cents(data["transaction_amount"]) <= 0 {
+		return http.StatusBadRequest, errorBody(http.StatusBadRequest, "bad_request", "Invalid transaction_amount", Object{"code": 4037, "description": "Invalid transaction_amount"})
+	}
+	paymentMethodID, _ := data["payment_method_id"].(string)
+	if paymentMethodID == "" {
+		return http.StatusBadRequest, errorBody(http.StatusBadRequest, "bad_request", "payment_method_id is required", Object{"code": 4020, "description": "payment_method_id is required"})
+	}
+	if paymentTypeOf(paymentMethodID) == "" {
+		return http.StatusBadRequest, errorBody(http.StatusBadRequest, "bad_request", "Invalid payment_method_id", Object{"code": 4033, "description": "Invalid payment_method_id"})
+	}
+	payer, _ := data["payer"].(map[string]interface{})
+	if email, _ := payer["email"].(string); !strings.Contains(email, "@") {
+		return http.StatusBadRequest, errorBody(http.StatusBadRequest, "bad_request", "payer.email must be a valid email", Object{"code": 4050, "description": "payer.email must be a valid email"})
+	}
+
+	id := server.nextPaymentID
+	server.nextPaymentID++
+
+	payment := Object{}
+	for key, value := range data {
+		payment[key] = value
+	}
+	payment["id"] = id
+	payment["date_created"] = server.now()
+	delete(payment, "status")
+	delete(payment, "status_detail")
+
+	switch paymentTypeOf(paymentMethodID) {
+	case "bank_transfer":
+		payment["status"], payment["status_detail"] = "pending", "pending_waiting_transfer"
+		if err := server.fillPixPayment(payment); err != nil {
+			return http.StatusInternalServerError, errorBody(http.StatusInternalServerError, "internal_error", err.Error())
+		}
+	case "ticket":
+		payment["status"], payment["status_detail"] = "pending", "pending_waiting_payment"
+		server.fillBoletoPayment(payment)
+	case "credit_card", "debit_card":
+		if result, ok := cardholderResult(payment); ok {
+			payment["status"], payment["status_detail"] = result.Status, result.StatusDetail
+		}
+	}
+
+	server.fillPayment(payment)
+	server.payments[id] = payment
+	server.idempotency[idempotencyKey] = id
+	return http.StatusCreated, payment
+}
+
+// updatePayment é o método que atualiza um pagamento. Assim como no MercadoPago só é possível cancelar pagamentos pendentes ou
+// capturar pagamentos autorizados, os demais campos enviados (metadata, external_reference...) são somente atualizados.
+func (server *Server) updatePayment(payment Object, data Object) (int, interface{}) {
+	status := toString(payment["status"])
+
+	switch toString(data["status"]) {
+	case "":
+	case "cancelled":
+		if status != "pending" && status != "in_process" && status != "authorized" {
+			return http.StatusBadRequest, errorBody(http.StatusBadRequest, "bad_request", "Invalid status update. Payment with status "+status+" can't be cancelled")
+		}
+		payment["status"], payment["status_detail"] = "cancelled", "by_collector"
+	default:
+		return http.StatusBadRequest, errorBody(http.StatusBadRequest, "bad_request", "Invalid status "+toString(data["status"]))
+	}
+
+	if capture, _ := data["capture"].(bool); capture {
+		if status != "authorized" {
+			return http.StatusBadRequest, errorBody(http.StatusBadRequest, "bad_request", "Payment with status "+status+" can't be captured")
+		}
+		payment["status"], payment["status_detail"], payment["captured"] = "approved", "accredited", true
+		payment["date_approved"] = server.now()
+	}
+
+	for _, key := range []string{"metadata", "external_reference", "description", "date_of_expiration", "notification_url"} {
+		if value, ok := data[key]; ok {
+			payment[key] = value
+		}
+	}
+
+	payment["date_last_updated"] = server.now()
+	return http.StatusOK, payment
+}
+
+// createRefund é o método que cria um reembolso total (sem amount) ou parcial de um pagamento aprovado.
+// Quando todo o valor é reembolsado o pagamento fica com status refunded, caso contrário o status continua approved com status_detail partially_refunded.
+func (server *Server) createRefund(payment Object, data Object) (int, interface{}) {
+	if status := toString(payment["status"]); status != "approved" {
+		return http.StatusBadRequest, errorBody(http.StatusBadRequest, "bad_request", "Payment with status "+status+" can't be refunded", Object{"code": 2063, "description": "The action requested is not valid for the current payment state"})
+	}
+
+	available := cents(payment["transaction_amount"]) - cents(payment["transaction_amount_refunded"])
+	refundAmount := available
+	if value, ok := data["amount"]; ok && value != nil {
+		refundAmount = cents(value)
+	}
+	if refundAmount <= 0 || refundAmount > available {
+		return http.StatusBadRequest, errorBody(http.StatusBadRequest, "bad_request", "Invalid refund amount", Object{"code": 4040, "description": "amount must be greater than zero and less than or equal to the available amount"})
+	}
+
+	refund := Object{
+		"id":                     server.nextRefundID,
+		"payment_id":             payment["id"],
+		"amount":                 amount(refundAmount),
+		"adjustment_amount":      0,
+		"metadata":               data["metadata"],
+		"source":                 Object{"id": strconv.FormatInt(server.CollectorID, 10), "name": "Test Collector", "type": "collector"},
+		"date_created":           server.now(),
+		"unique_sequence_number": nil,
+		"refund_mode":            "standard",
+		"reason":                 nil,
+		"status":                 "approved",
+	}
+	server.nextRefundID++
+
+	refunds, _ := payment["refunds"].([]interface{})
+	payment["refunds"] = append(refunds, refund)
+	payment["transaction_amount_refunded"] = amount(cents(payment["transaction_amount_refunded"]) + refundAmount)
+	payment["date_last_updated"] = server.now()
+	if refundAmount == available {
+		payment["status"], payment["status_detail"] = "refunded", "refunded"
+	} else {
+		payment["status_detail"] = "partially_refunded"
+	}
+
+	return http.StatusCreated, refund
+}
+
+// searchPayments é o método que busca os pagamentos filtrando pelos campos informados na query (exemplo: external_reference=test-00001, status=approved).
+// Também é possível filtrar por período usando range=date_created, begin_date e end_date.
+func (server *Server) searchPayments(query url.Values) Object {
+	results := []interface{}{}
+	for _, payment := range server.payments {
+		if matchQuery(payment, query) {
+			results = append(results, payment)
+		}
+	}
+
+	sortByDateCreated(results)
+	if strings.EqualFold(query.Get("criteria"), "desc") {
+		for i, j := 0, len(results)-1; i < j; i, j = i+1, j-1 {
+			results[i], results[j] = results[j], results[i]
+		}
+	}
+
+	offset, limit := pagination(query)
+	return Object{
+		"paging":  Object{"total": len(results), "limit": limit, "offset": offset},
+		"results": paginate(results, offset, limit),
+	}
+}
+
+// fillPayment é o método que preenche os campos gerados pelo MercadoPago no pagamento, sem substituir os campos já informados.
+// Quando o status não é informado o pagamento é aprovado, como acontece com pagamentos em cartão ou saldo em conta.
+func (server *Server) fillPayment(payment Object) {
+	paymentMethodID := toString(payment["payment_method_id"])
+	if paymentMethodID == "" {
+		paymentMethodID = "account_money"
+	}
+
+	defaults := Object{
+		"payment_method_id":           paymentMethodID,
+		"payment_type_id":             paymentTypeOf(paymentMethodID),
+		"status":                      "approved",
+		"status_detail":               "accredited",
+		"date_created":                server.now(),
+		"collector_id":                server.CollectorID,
+		"currency_id":                 "BRL",
+		"operation_type":              "regular_payment",
+		"live_mode":                   false,
+		"captured":                    true,
+		"binary_mode":                 false,
+		"installments":                1,
+		"transaction_amount_refunded": 0,
+		"refunds":                     []interface{}{},
+		"fee_details":                 []interface{}{},
+		"charges_details":             []interface{}{},
+		"metadata":                    Object{},
+	}
+	for key, value := range defaults {
+		if _, ok := payment[key]; !ok {
+			payment[key] = value
+		}
+	}
+
+	if payment["status"] == "approved" {
+		if _, ok := payment["date_approved"]; !ok {
+			payment["date_approved"] = payment["date_created"]
+		}
+	}
+	if _, ok := payment["date_last_updated"]; !ok {
+		payment["date_last_updated"] = payment["date_created"]
+	}
+
+	if _, ok := payment["transaction_details"]; !ok {
+		transactionAmount := amount(cents(payment["transaction_amount"]))
+		payment["transaction_details"] = Object{
+			"total_paid_amount":   transactionAmount,
+			"installment_amount":  transactionAmount,
+			"net_received_amount": transactionAmount,
+			"overpaid_amount":     0,
+		}
+	}
+}
+
+// fillPixPayment é o método que gera o Pix Copia-e-Cola (BR Code) e a imagem do QRCode de um pagamento Pix.
+func (server *Server) fillPixPayment(payment Object) error {
+	if payment["date_of_expiration"] == nil {
+		payment["date_of_expiration"] = server.Now().Add(PixExpiration).In(time.FixedZone("GMT-4", -4*60*60)).Format(TimeLayout)
+	}
+
+	id := toString(payment["id"])
+	code, err := pix.Static{
+		Key:          newUUID(),
+		MerchantName: "MERCADO PAGO TESTE",
+		MerchantCity: "SAO PAULO",
+		Amount:       cents(payment["transaction_amount"]),
+		TxID:         "MP" + id,
+	}.Encode()
+	if err != nil {
+		return err
+	}
+
+	qrCode, err := qrcode.Encode([]byte(code), qrcode.LevelM)
+	if err != nil {
+		return err
+	}
+	png, err := qrCode.PNG(300)
+	if err != nil {
+		return err
+	}
+
+	payment["point_of_interaction"] = Object{
+		"type":     "OPENPLATFORM",
+		"sub_type": nil,
+		"transaction_data": Object{
+			"qr_code":        code,
+			"qr_code_base64": base64.StdEncoding.EncodeToString(png),
+			"ticket_url":     "https://www.mercadopago.com.br/payments/" + id + "/ticket?caller_id=" + strconv.FormatInt(server.CollectorID, 10),
+		},
+	}
+	return nil
+}
+
+// fillBoletoPayment é o método que gera o código de barras válido (banco 237) e a URL do boleto de um pagamento em boleto ou PEC.
+func (server *Server) fillBoletoPayment(payment Object) {
+	dueDate := server.Now().Add(BoletoExpiration)
+	if payment["date_of_expiration"] == nil {
+		payment["date_of_expiration"] = dueDate.In(time.FixedZone("GMT-4", -4*60*60)).Format(TimeLayout)
+	} else if date, err := time.Parse(TimeLayout, toString(payment["date_of_expiration"])); err == nil {
+		dueDate = date
+	}
+
+	barcode := fmt.Sprintf("2379%d%04d%010d%025d", 0, boleto.DueFactor(dueDate), cents(payment["transaction_amount"]), payment["id"])
+	barcode = barcode[:4] + strconv.Itoa(boleto.BarcodeCheckDigit(barcode)) + barcode[5:]
+	ticketURL := "https://www.mercadopago.com.br/payments/" + toString(payment["id"]) + "/ticket?caller_id=" + strconv.FormatInt(server.CollectorID, 10)
+
+	payment["barcode"] = Object{"content": barcode}
+	payment["transaction_details"] = Object{
+		"external_resource_url": ticketURL,
+		"financial_institution": "bradesco",
+		"total_paid_amount":     amount(cents(payment["transaction_amount"])),
+		"installment_amount":    0,
+		"net_received_amount":   0,
+		"overpaid_amount":       0,
+	}
+}
@@ -0,0 +1,157 @@
+package mercadopagotest
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// AddPreference é o método que adiciona uma preferência de pagamento no servidor, útil para preparar o estado antes de um teste.
+// A preferência pode ser qualquer struct ou map no formato JSON do MercadoPago, caso não possua ID então é gerado um novo ID.
+// Retorna o ID da preferência adicionada.
+func (server *Server) AddPreference(preference interface{}) (string, error) {
+	object, err := toObject(preference)
+	if err != nil {
+		return "", err
+	}
+
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	id, _ := object["id"].(string)
+	if id == "" {
+		id = server.newPreferenceID()
+	}
+	server.preferences[id] = server.fillPreference(id, object)
+	return id, nil
+}
+
+// Preference é o método que retorna uma cópia da preferência de pagamento salva no servidor.
+func (server *Server) Preference(id string) (Object, bool) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	preference, ok := server.preferences[id]
+	if !ok {
+		return nil, false
+	}
+	return copyObject(preference), true
+}
+
+// preferencesRoute é o método que trata as requisições do recurso /checkout/preferences.
+func (server *Server) preferencesRoute(method string, segments []string, query url.Values, data Object) (int, interface{}) {
+	switch {
+	case len(segments) == 0 && method == http.MethodPost:
+		if status, err := validatePreference(data); err != nil {
+			return status, err
+		}
+		id := server.newPreferenceID()
+		server.preferences[id] = server.fillPreference(id, data)
+		return http.StatusCreated, server.preferences[id]
+
+	case len(segments) == 1 && segments[0] == "search" && method == http.MethodGet:
+		return http.StatusOK, server.searchPreferences(query)
+
+	case len(segments) == 1 && method == http.MethodGet:
+		preference, ok := server.preferences[segments[0]]
+		if !ok {
+			return http.StatusNotFound, errorBody(http.StatusNotFound, "not_found", "preference_id "+segments[0]+" not found")
+		}
+		return http.StatusOK, preference
+
+	case len(segments) == 1 && method == http.MethodPut:
+		preference, ok := server.preferences[segments[0]]
+		if !ok {
+			return http.StatusNotFound, errorBody(http.StatusNotFound, "not_found", "preference_id "+segments[0]+" not found")
+		}
+		if status, err := validatePreference(data); err != nil {
+			return status, err
+		}
+		for key, value := range data {
+			if key != "id" && key != "date_created" && key != "collector_id" {
+				preference[key] = value
+			}
+		}
+		server.preferences[segments[0]] = server.fillPreference(segments[0], preference)
+		return http.StatusOK, server.preferences[segments[0]]
+	}
+
+	return http.StatusMethodNotAllowed, errorBody(http.StatusMethodNotAllowed, "method_not_allowed", "method "+method+" not allowed")
+}
+
+// validatePreference é a função que valida os itens da preferência da mesma forma que o MercadoPago, exigindo título, quantidade e preço unitário.
+func validatePreference(data Object) (int, Object) {
+	items, _ := data["items"].([]interface{})
+	if len(items) == 0 {
+		return http.StatusBadRequest, errorBody(http.StatusBadRequest, "invalid_items", "items needed")
+	}
+
+	for i, value := range items {
+		item, _ := value.(map[string]interface{})
+		field := "items[" + strconv.Itoa(i) + "]"
+		if title, _ := item["title"].(string); title == "" {
+			return http.StatusBadRequest, errorBody(http.StatusBadRequest, "invalid_items", field+".title is required", Object{"code": "invalid_items", "description": field + ".title is required"})
+		}
+		if number(item["quantity"]) <= 0 {
+			return http.StatusBadRequest, errorBody(http.StatusBadRequest, "invalid_items", field+".quantity must be a number greater than 0", Object{"code": "invalid_items", "description": field + ".quantity must be a number greater than 0"})
+		}
+		if number(item["unit_price"]) <= 0 {
+			return http.StatusBadRequest, errorBody(http.StatusBadRequest, "invalid_items", field+".unit_price invalid", Object{"code": "invalid_items", "description": field + ".unit_price invalid"})
+		}
+	}
+	return 0, nil
+}
+
+// fillPreference é o método que preenche os campos gerados pelo MercadoPago na preferência (ID, data de criação, links de pagamento...).
+func (server *Server) fillPreference(id string, preference Object) Object {
+	preference["id"] = id
+	if _, ok := preference["date_created"]; !ok {
+		preference["date_created"] = server.now()
+	}
+	if _, ok := preference["collector_id"]; !ok {
+		preference["collector_id"] = server.CollectorID
+	}
+	if _, ok := preference["operation_type"]; !ok {
+		preference["operation_type"] = "regular_payment"
+	}
+	if _, ok := preference["site_id"]; !ok {
+		preference["site_id"] = "MLB"
+	}
+	preference["init_point"] = "https://www.mercadopago.com.br/checkout/v1/redirect?pref_id=" + id
+	preference["sandbox_init_point"] = "https://sandbox.mercadopago.com.br/checkout/v1/redirect?pref_id=" + id
+	return preference
+}
+
+// searchPreferences é o método que busca as preferências filtrando pelos campos informados na query (exemplo: external_reference=test-00001).
+func (server *Server) searchPreferences(query url.Values) Object {
+	elements := []interface{}{}
+	for _, preference := range server.preferences {
+		if !matchQuery(preference, query) {
+			continue
+		}
+
+		titles := []string{}
+		items, _ := preference["items"].([]interface{})
+		for _, value := range items {
+			item, _ := value.(map[string]interface{})
+			title, _ := item["title"].(string)
+			titles = append(titles, title)
+		}
+
+		payer, _ := preference["payer"].(map[string]interface{})
+		element := Object{"items": titles, "payer_email": payer["email"]}
+		for _, key := range []string{"id", "collector_id", "external_reference", "date_created", "expiration_date_from", "expiration_date_to", "expires", "marketplace", "site_id", "operation_type"} {
+			element[key] = preference[key]
+		}
+		elements = append(elements, element)
+	}
+
+	sortByDateCreated(elements)
+	offset, limit := pagination(query)
+	page := paginate(elements, offset, limit)
+	return Object{"elements": page, "total": len(elements), "next_offset": offset + len(page)}
+}
+
+// newPreferenceID é o método que gera um novo ID de preferência no formato do MercadoPago ({collector_id}-{uuid}).
+func (server *Server) newPreferenceID() string {
+	return strconv.FormatInt(server.CollectorID, 10) + "-" + newUUID()
+}
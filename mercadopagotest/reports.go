@@ -0,0 +1,266 @@
+package mercadopagotest
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Relatórios financeiros emulados pelo servidor
+const (
+	ReportSettlement = "settlement_report" // Relatório de liquidações
+	ReportRelease    = "release_report"    // Relatório de liberações
+)
+
+// reportColumns são as colunas usadas nos relatórios gerados quando as configurações não informam as colunas.
+var reportColumns = map[string][]string{
+	ReportSettlement: {
+		"EXTERNAL_REFERENCE", "SOURCE_ID", "USER_ID", "PAYMENT_METHOD_TYPE", "PAYMENT_METHOD", "SITE", "TRANSACTION_TYPE", "TRANSACTION_AMOUNT",
+		"TRANSACTION_CURRENCY", "TRANSACTION_DATE", "FEE_AMOUNT", "SETTLEMENT_NET_AMOUNT", "SETTLEMENT_CURRENCY", "SETTLEMENT_DATE", "MONEY_RELEASE_DATE", "INSTALLMENTS",
+	},
+	ReportRelease: {
+		"DATE", "SOURCE_ID", "EXTERNAL_REFERENCE", "RECORD_TYPE", "DESCRIPTION", "NET_CREDIT_AMOUNT", "NET_DEBIT_AMOUNT", "GROSS_AMOUNT", "MP_FEE_AMOUNT",
+		"INSTALLMENTS", "PAYMENT_METHOD", "PAYMENT_METHOD_TYPE", "CURRENCY",
+	},
+}
+
+// AddReport é o método que adiciona um relatório já gerado no servidor, permitindo testar o download de qualquer conteúdo CSV.
+func (server *Server) AddReport(reportType, fileName string, content []byte) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	now := server.now()
+	server.reports[reportType] = append(server.reports[reportType], Object{
+		"id":           server.nextReportID,
+		"user_id":      server.CollectorID,
+		"file_name":    fileName,
+		"created_from": "manual",
+		"begin_date":   now,
+		"end_date":     now,
+		"date_created": now,
+	})
+	server.nextReportID++
+	server.reportFiles[reportType+"/"+fileName] = content
+}
+
+// ReportConfig é o método que retorna uma cópia das configurações do relatório salvas no servidor.
+func (server *Server) ReportConfig(reportType string) (Object, bool) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	config, ok := server.reportConfigs[reportType]
+	if !ok {
+		return nil, false
+	}
+	return copyObject(config), true
+}
+
+// reportsRoute é o método que trata as requisições dos recursos /v1/account/settlement_report e /v1/account/release_report.
+// Os relatórios são gerados no momento da criação a partir dos pagamentos salvos no servidor, sem passar pelo status pending.
+func (server *Server) reportsRoute(method, reportType string, segments []string, data Object) (int, interface{}) {
+	if reportType != ReportSettlement && reportType != ReportRelease {
+		return http.StatusNotFound, errorBody(http.StatusNotFound, "not_found", "report "+reportType+" not found")
+	}
+
+	switch {
+	case len(segments) == 1 && segments[0] == "config" && method == http.MethodGet:
+		config, ok := server.reportConfigs[reportType]
+		if !ok {
+			return http.StatusNotFound, errorBody(http.StatusNotFound, "not_found", "config not found")
+		}
+		return http.StatusOK, config
+
+	case len(segments) == 1 && segments[0] == "config" && method == http.MethodPost:
+		if _, ok := server.reportConfigs[reportType]; ok {
+			return http.StatusBadRequest, errorBody(http.StatusBadRequest, "bad_request", "config already exists")
+		}
+		config := Object{"file_name_prefix": reportType + "-" + strconv.FormatInt(server.CollectorID, 10), "separator": ",", "scheduled": false}
+		for key, value := range data {
+			config[key] = value
+		}
+		server.reportConfigs[reportType] = config
+		return http.StatusCreated, config
+
+	case len(segments) == 1 && segments[0] == "config" && method == http.MethodPut:
+		config, ok := server.reportConfigs[reportType]
+		if !ok {
+			return http.StatusNotFound, errorBody(http.StatusNotFound, "not_found", "config not found")
+		}
+		for key, value := range data {
+			config[key] = value
+		}
+		return http.StatusOK, config
+
+	case len(segments) == 0 && method == http.MethodPost:
+		return server.createReport(reportType, data)
+
+	case len(segments) == 1 && segments[0] == "list" && method == http.MethodGet:
+		reports := server.reports[reportType]
+		if reports == nil {
+			reports = []Object{}
+		}
+		return http.StatusOK, reports
+
+	case len(segments) == 1 && method == http.MethodGet:
+		content, ok := server.reportFiles[reportType+"/"+segments[0]]
+		if !ok {
+			return http.StatusNotFound, errorBody(http.StatusNotFound, "not_found", "file "+segments[0]+" not found")
+		}
+		return http.StatusOK, rawFile{contentType: "text/csv", body: content}
+	}
+
+	return http.StatusNotFound, errorBody(http.StatusNotFound, "not_found", "resource not found")
+}
+
+// createReport é o método que gera o relatório do período informado com os pagamentos salvos no servidor.
+func (server *Server) createReport(reportType string, data Object) (int, interface{}) {
+	config, ok := server.reportConfigs[reportType]
+	if !ok {
+		return http.StatusNotFound, errorBody(http.StatusNotFound, "not_found", "config not found")
+	}
+
+	beginDate, beginErr := time.Parse(time.RFC3339, toString(data["begin_date"]))
+	endDate, endErr := time.Parse(time.RFC3339, toString(data["end_date"]))
+	if beginErr != nil || endErr != nil || endDate.Before(beginDate) {
+		return http.StatusBadRequest, errorBody(http.StatusBadRequest, "bad_request", "invalid begin_date or end_date")
+	}
+
+	columns := reportColumns[reportType]
+	if configColumns, ok := config["columns"].([]interface{}); ok && len(configColumns) > 0 {
+		columns = nil
+		for _, column := range configColumns {
+			if column, ok := column.(map[string]interface{}); ok {
+				columns = append(columns, toString(column["key"]))
+			}
+		}
+	}
+
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+	if separator := toString(config["separator"]); separator == ";" {
+		writer.Comma = ';'
+	}
+	writer.Write(columns)
+
+	payments := []interface{}{}
+	for _, payment := range server.payments {
+		dateCreated, err := time.Parse(TimeLayout, toString(payment["date_created"]))
+		if err == nil && !dateCreated.Before(beginDate) && !dateCreated.After(endDate) {
+			payments = append(payments, payment)
+		}
+	}
+	sortByDateCreated(payments)
+
+	for _, payment := range payments {
+		for _, row := range reportRows(reportType, payment.(Object)) {
+			record := make([]string, len(columns))
+			for i, column := range columns {
+				record[i] = row[column]
+			}
+			writer.Write(record)
+		}
+	}
+	writer.Flush()
+
+	now := server.Now()
+	fileName := fmt.Sprintf("%s-%s-%d.csv", toString(config["file_name_prefix"]), now.UTC().Format("2006-01-02-150405"), server.nextReportID)
+	report := Object{
+		"id":              server.nextReportID,
+		"user_id":         server.CollectorID,
+		"account_id":      server.CollectorID,
+		"begin_date":      beginDate.Format(time.RFC3339),
+		"end_date":        endDate.Format(time.RFC3339),
+		"file_name":       fileName,
+		"created_from":    "manual",
+		"status":          "processed",
+		"format":          "CSV",
+		"date_created":    server.now(),
+		"generation_date": server.now(),
+		"last_modified":   server.now(),
+	}
+	server.nextReportID++
+	server.reports[reportType] = append(server.reports[reportType], report)
+	server.reportFiles[reportType+"/"+fileName] = buffer.Bytes()
+	return http.StatusAccepted, report
+}
+
+// reportRows é a função que monta as linhas do relatório de um pagamento: uma linha do pagamento recebido e uma linha do valor reembolsado.
+// Pagamentos que não foram recebidos (pendentes, rejeitados ou cancelados) não aparecem nos relatórios.
+func reportRows(reportType string, payment Object) []map[string]string {
+	switch toString(payment["status"]) {
+	case "approved", "authorized", "in_mediation", "refunded", "charged_back":
+	default:
+		return nil
+	}
+
+	fee := int64(0)
+	if feeDetails, ok := payment["fee_details"].([]interface{}); ok {
+		for _, feeDetail := range feeDetails {
+			if feeDetail, ok := feeDetail.(map[string]interface{}); ok {
+				fee += cents(feeDetail["amount"])
+			}
+		}
+	}
+
+	transactionAmount := cents(payment["transaction_amount"])
+	refunded := cents(payment["transaction_amount_refunded"])
+	date := toString(payment["date_approved"])
+	if date == "" {
+		date = toString(payment["date_created"])
+	}
+
+	common := map[string]string{
+		"SOURCE_ID":            toString(payment["id"]),
+		"EXTERNAL_REFERENCE":   toString(payment["external_reference"]),
+		"USER_ID":              toString(payment["collector_id"]),
+		"PAYMENT_METHOD":       toString(payment["payment_method_id"]),
+		"PAYMENT_METHOD_TYPE":  toString(payment["payment_type_id"]),
+		"SITE":                 "MLB",
+		"INSTALLMENTS":         toString(payment["installments"]),
+		"TRANSACTION_CURRENCY": toString(payment["currency_id"]),
+		"SETTLEMENT_CURRENCY":  toString(payment["currency_id"]),
+		"CURRENCY":             toString(payment["currency_id"]),
+		"TRANSACTION_DATE":     toString(payment["date_created"]),
+		"SETTLEMENT_DATE":      date,
+		"MONEY_RELEASE_DATE":   date,
+		"DATE":                 date,
+	}
+
+	rows := []map[string]string{reportRow(reportType, common, "SETTLEMENT", "payment", transactionAmount, -fee)}
+	if refunded > 0 {
+		rows = append(rows, reportRow(reportType, common, "REFUND", "refund", -refunded, 0))
+	}
+	return rows
+}
+
+// reportRow é a função que monta uma linha do relatório com os valores da transação, os valores negativos são débitos.
+func reportRow(reportType string, common map[string]string, transactionType, description string, transactionAmount, fee int64) map[string]string {
+	row := map[string]string{}
+	for key, value := range common {
+		row[key] = value
+	}
+
+	net := transactionAmount + fee
+	row["TRANSACTION_TYPE"] = transactionType
+	row["DESCRIPTION"] = description
+	row["RECORD_TYPE"] = "release"
+	row["TRANSACTION_AMOUNT"] = formatCents(transactionAmount)
+	row["GROSS_AMOUNT"] = formatCents(transactionAmount)
+	row["FEE_AMOUNT"] = formatCents(fee)
+	row["MP_FEE_AMOUNT"] = formatCents(fee)
+	row["SETTLEMENT_NET_AMOUNT"] = formatCents(net)
+	row["NET_CREDIT_AMOUNT"], row["NET_DEBIT_AMOUNT"] = formatCents(0), formatCents(0)
+	if net >= 0 {
+		row["NET_CREDIT_AMOUNT"] = formatCents(net)
+	} else {
+		row["NET_DEBIT_AMOUNT"] = formatCents(-net)
+	}
+	return row
+}
+
+// formatCents é a função que formata um valor em centavos com duas casas decimais, como nos relatórios do MercadoPago.
+func formatCents(value int64) string {
+	return strconv.FormatFloat(amount(value), 'f', 2, 64)
+}
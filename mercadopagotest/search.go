@@ -0,0 +1,132 @@
+package mercadopagotest
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultLimit é a quantidade de resultados retornados nas buscas quando o limit não é informado.
+const DefaultLimit = 30
+
+// ignoredQueryParams são os parametros da query que não são filtros e sim configurações da busca.
+var ignoredQueryParams = map[string]bool{"offset": true, "limit": true, "sort": true, "criteria": true, "range": true, "begin_date": true, "end_date": true}
+
+// matchQuery é a função que indica se o objeto possui todos os campos filtrados na query com o mesmo valor.
+// Os campos de objetos internos são filtrados usando ponto (exemplo: payer.email=test@test.com).
+func matchQuery(object Object, query url.Values) bool {
+	for key, values := range query {
+		if ignoredQueryParams[key] || len(values) == 0 {
+			continue
+		}
+		if toString(lookup(object, key)) != values[0] {
+			return false
+		}
+	}
+
+	field := query.Get("range")
+	if field == "" {
+		return true
+	}
+	value := toString(lookup(object, field))
+	if begin := query.Get("begin_date"); begin != "" && value < begin {
+		return false
+	}
+	if end := query.Get("end_date"); end != "" && value > end {
+		return false
+	}
+	return true
+}
+
+// lookup é a função que retorna o valor de um campo do objeto, navegando nos objetos internos separados por ponto.
+func lookup(object Object, path string) interface{} {
+	var value interface{} = object
+	for _, key := range strings.Split(path, ".") {
+		current, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = current[key]
+	}
+	return value
+}
+
+// toString é a função que converte um valor do JSON para string, usada para comparar os filtros da query.
+func toString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// number é a função que converte um valor do JSON para float64, retornando zero quando o valor não é um número.
+func number(value interface{}) float64 {
+	switch v := value.(type) {
+	case float64:
+		return v
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case string:
+		parsed, _ := strconv.ParseFloat(v, 64)
+		return parsed
+	}
+	return 0
+}
+
+// cents é a função que converte um valor do JSON em centavos, evitando erros de arredondamento nas somas dos valores.
+func cents(value interface{}) int64 {
+	return int64(math.Round(number(value) * 100))
+}
+
+// amount é a função que converte um valor em centavos para o número usado no JSON.
+func amount(cents int64) float64 {
+	return float64(cents) / 100
+}
+
+// sortByDateCreated é a função que ordena os objetos pela data de criação, do mais antigo para o mais recente.
+// As datas são comparadas como texto pois todas possuem o mesmo formato e fuso horário.
+func sortByDateCreated(elements []interface{}) {
+	sort.SliceStable(elements, func(i, j int) bool {
+		a, b := elements[i].(map[string]interface{}), elements[j].(map[string]interface{})
+		if dateA, dateB := toString(a["date_created"]), toString(b["date_created"]); dateA != dateB {
+			return dateA < dateB
+		}
+		return toString(a["id"]) < toString(b["id"])
+	})
+}
+
+// pagination é a função que retorna o offset e o limit informados na query.
+func pagination(query url.Values) (int, int) {
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = DefaultLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return offset, limit
+}
+
+// paginate é a função que retorna a página dos elementos de acordo com o offset e o limit.
+func paginate(elements []interface{}, offset, limit int) []interface{} {
+	if offset >= len(elements) {
+		return []interface{}{}
+	}
+	end := offset + limit
+	if end > len(elements) {
+		end = len(elements)
+	}
+	return elements[offset:end]
+}
@@ -0,0 +1,347 @@
+// Package mercadopagotest contém um servidor HTTP em memória que emula a API do MercadoPago (preferências, pagamentos, reembolsos,
+// clientes, tipos de documento, métodos de pagamento, relatórios financeiros, saldo da conta e usuários de testes), permitindo testar integrações com o SDK sem acesso à internet.
+//
+// O servidor guarda o estado dos recursos criados (um pagamento criado pode ser consultado, buscado e reembolsado depois) e permite
+// simular falhas nas requisições (status HTTP de erro, latência e JSON malformado).
+//
+//	server := mercadopagotest.NewServer()
+//	defer server.Close()
+//	defer server.Setenv()()
+//
+//	payment, mercadopagoErr, err := mercadopago.CreatePixPayment(...)
+package mercadopagotest
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// BaseURLEnv é o nome da variavel de ambiente usada pelo SDK para substituir a URL da API do MercadoPago.
+const BaseURLEnv = "MERCADO_PAGO_BASE_URL"
+
+// TimeLayout é o formato das datas retornadas pelo servidor, o mesmo usado pelo MercadoPago.
+const TimeLayout = "2006-01-02T15:04:05.000-07:00"
+
+// Object é o tipo usado para guardar os recursos do servidor (preferências, pagamentos, clientes...) no mesmo formato JSON retornado pelo MercadoPago.
+type Object = map[string]interface{}
+
+// Server é o servidor de testes que emula a API do MercadoPago.
+type Server struct {
+	URL         string // URL base do servidor (exemplo: http://127.0.0.1:53212)
+	AccessToken string // Quando informado, somente esse access token é aceito. Quando vazio qualquer token é aceito, mas o header Authorization é obrigatório.
+	CollectorID int64  // ID da conta do vendedor usado nos recursos criados
+
+	// WebhookSecret é a assinatura secreta usada para gerar o header x-signature dos webhooks enviados pelo simulador de pagamentos.
+	WebhookSecret string
+	// NotificationURL é a URL que recebe os webhooks dos pagamentos que não possuem notification_url.
+	NotificationURL string
+
+	// Now é a função que retorna a data atual usada nos recursos criados, pode ser substituída para tornar os testes determinísticos.
+	Now func() time.Time
+
+	server         *httptest.Server
+	mutex          sync.Mutex
+	preferences    map[string]Object
+	payments       map[int64]Object
+	customers      map[string]Object
+	reportConfigs  map[string]Object
+	reports        map[string][]Object
+	reportFiles    map[string][]byte
+	testUsers      []Object
+	idempotency    map[string]int64
+	nextPaymentID  int64
+	nextRefundID   int64
+	nextCustomerID int64
+	failures       []*Failure
+	requests       []Request
+	webhooks       []Webhook
+	nextWebhookID  int64
+	nextReportID   int64
+	nextTestUserID int64
+}
+
+// Request é a struct que contém as informações de uma requisição recebida pelo servidor, usada para verificar o que o SDK enviou.
+type Request struct {
+	Method  string      // Método HTTP
+	Path    string      // Caminho da URL (exemplo: /v1/payments)
+	Query   string      // QueryParams da URL sem o ?
+	Headers http.Header // Headers da requisição
+	Body    []byte      // Body da requisição
+}
+
+// Failure é a struct que descreve uma falha simulada nas requisições que correspondem ao método e ao caminho informados.
+type Failure struct {
+	Method     string        // Método HTTP da requisição (vazio para todos os métodos)
+	Path       string        // Caminho da requisição, terminado em * para todos os caminhos que começam com o prefixo (vazio para todos os caminhos)
+	StatusCode int           // Status HTTP retornado (zero para processar a requisição normalmente após a latência)
+	Body       string        // Body retornado junto com o StatusCode, pode ser um JSON malformado (vazio para o erro padrão do MercadoPago)
+	Latency    time.Duration // Tempo de espera antes de responder
+	Times      int           // Número de requisições que irão falhar (zero para todas as requisições)
+}
+
+// NewServer é a função que inicia um novo servidor de testes com os tipos de documento e métodos de pagamento do Brasil.
+func NewServer() *Server {
+	server := &Server{
+		CollectorID:    123456789,
+		WebhookSecret:  "mercadopagotest-webhook-secret",
+		Now:            time.Now,
+		preferences:    map[string]Object{},
+		payments:       map[int64]Object{},
+		customers:      map[string]Object{},
+		reportConfigs:  map[string]Object{},
+		reports:        map[string][]Object{},
+		reportFiles:    map[string][]byte{},
+		idempotency:    map[string]int64{},
+		nextPaymentID:  1000000001,
+		nextRefundID:   2000000001,
+		nextCustomerID: 100000001,
+		nextWebhookID:  30000000001,
+		nextReportID:   40000001,
+		nextTestUserID: 1100000001,
+	}
+	server.server = httptest.NewServer(http.HandlerFunc(server.serveHTTP))
+	server.URL = server.server.URL
+	return server
+}
+
+// Close é o método que encerra o servidor.
+func (server *Server) Close() {
+	server.server.Close()
+}
+
+// Setenv é o método que define a variavel de ambiente MERCADO_PAGO_BASE_URL com a URL do servidor, fazendo o SDK enviar todas as requisições para ele.
+// Retorna a função que restaura o valor anterior da variavel de ambiente.
+func (server *Server) Setenv() func() {
+	previous, exists := os.LookupEnv(BaseURLEnv)
+	os.Setenv(BaseURLEnv, server.URL)
+	return func() {
+		if exists {
+			os.Setenv(BaseURLEnv, previous)
+		} else {
+			os.Unsetenv(BaseURLEnv)
+		}
+	}
+}
+
+// Fail é o método que adiciona uma falha simulada. As falhas são verificadas na ordem em que foram adicionadas.
+func (server *Server) Fail(failure Failure) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.failures = append(server.failures, &failure)
+}
+
+// FailNext é o método que faz a próxima requisição ao método e caminho informados retornar o status HTTP informado com o erro padrão do MercadoPago.
+func (server *Server) FailNext(method, path string, statusCode int) {
+	server.Fail(Failure{Method: method, Path: path, StatusCode: statusCode, Times: 1})
+}
+
+// Delay é o método que adiciona latência em todas as requisições ao método e caminho informados.
+func (server *Server) Delay(method, path string, latency time.Duration) {
+	server.Fail(Failure{Method: method, Path: path, Latency: latency})
+}
+
+// MalformedJSON é o método que faz a próxima requisição ao método e caminho informados retornar status 200 com um JSON malformado.
+func (server *Server) MalformedJSON(method, path string) {
+	server.Fail(Failure{Method: method, Path: path, StatusCode: http.StatusOK, Body: `{"id": `, Times: 1})
+}
+
+// ClearFailures é o método que remove todas as falhas simuladas.
+func (server *Server) ClearFailures() {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.failures = nil
+}
+
+// Requests é o método que retorna todas as requisições recebidas pelo servidor, na ordem em que foram recebidas.
+func (server *Server) Requests() []Request {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	return append([]Request(nil), server.requests...)
+}
+
+// LastRequest é o método que retorna a última requisição recebida pelo servidor.
+func (server *Server) LastRequest() (Request, bool) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	if len(server.requests) == 0 {
+		return Request{}, false
+	}
+	return server.requests[len(server.requests)-1], true
+}
+
+// serveHTTP é o método que recebe todas as requisições, aplica as falhas simuladas, valida o access token e direciona para o recurso correspondente.
+func (server *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+
+	server.mutex.Lock()
+	server.requests = append(server.requests, Request{Method: r.Method, Path: r.URL.Path, Query: r.URL.RawQuery, Headers: r.Header.Clone(), Body: body})
+	failure := server.matchFailure(r.Method, r.URL.Path)
+	server.mutex.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-Id", newUUID())
+
+	if failure != nil {
+		if failure.Latency > 0 {
+			select {
+			case <-time.After(failure.Latency):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		if failure.StatusCode != 0 {
+			w.WriteHeader(failure.StatusCode)
+			if failure.Body != "" {
+				w.Write([]byte(failure.Body))
+			} else {
+				json.NewEncoder(w).Encode(errorBody(failure.StatusCode, strings.ToLower(strings.ReplaceAll(http.StatusText(failure.StatusCode), " ", "_")), "simulated failure"))
+			}
+			return
+		}
+	}
+
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" || token == r.Header.Get("Authorization") || (server.AccessToken != "" && token != server.AccessToken) {
+		writeError(w, http.StatusUnauthorized, "unauthorized", "invalid access token")
+		return
+	}
+
+	var data Object
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &data); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "invalid json body")
+			return
+		}
+	}
+
+	// O JSON da resposta é gerado com o mutex travado pois os objetos retornados são os mesmos guardados no estado do servidor
+	server.mutex.Lock()
+	status, response := server.route(r, data)
+	responseBody, _ := json.Marshal(response)
+	if file, ok := response.(rawFile); ok {
+		w.Header().Set("Content-Type", file.contentType)
+		responseBody = file.body
+	}
+	server.mutex.Unlock()
+
+	w.WriteHeader(status)
+	w.Write(responseBody)
+}
+
+// rawFile é a struct usada pelos recursos que retornam arquivos em vez de JSON (exemplo: relatórios em CSV).
+type rawFile struct {
+	contentType string
+	body        []byte
+}
+
+// route é o método que direciona a requisição para o recurso correspondente. Deve ser chamado com o mutex travado.
+func (server *Server) route(r *http.Request, data Object) (int, interface{}) {
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	query := r.URL.Query()
+
+	switch {
+	case match(segments, "checkout", "preferences"):
+		return server.preferencesRoute(r.Method, segments[2:], query, data)
+	case match(segments, "v1", "payments"):
+		return server.paymentsRoute(r.Method, segments[2:], query, data, r.Header.Get("X-Idempotency-Key"))
+	case match(segments, "v1", "customers"):
+		return server.customersRoute(r.Method, segments[2:], query, data)
+	case match(segments, "v1", "account") && len(segments) > 2:
+		return server.reportsRoute(r.Method, segments[2], segments[3:], data)
+	case match(segments, "users") && len(segments) == 4, match(segments, "mercadopago_account", "movements"):
+		return server.accountRoute(r.Method, segments, query)
+	case match(segments, "users") && len(segments) == 2:
+		return server.usersRoute(r.Method, segments[1], data)
+	case match(segments, "v1", "identification_types") && len(segments) == 2 && r.Method == http.MethodGet:
+		return http.StatusOK, identificationTypes
+	case match(segments, "v1", "payment_methods") && len(segments) == 2 && r.Method == http.MethodGet:
+		return http.StatusOK, paymentMethods
+	}
+
+	return http.StatusNotFound, errorBody(http.StatusNotFound, "not_found", "resource "+r.URL.Path+" not found")
+}
+
+// matchFailure é o método que retorna a primeira falha simulada que corresponde à requisição, descontando uma das vezes da falha. Deve ser chamado com o mutex travado.
+func (server *Server) matchFailure(method, path string) *Failure {
+	for i, failure := range server.failures {
+		if failure.Method != "" && !strings.EqualFold(failure.Method, method) {
+			continue
+		}
+		if failure.Path != "" && failure.Path != path && !(strings.HasSuffix(failure.Path, "*") && strings.HasPrefix(path, strings.TrimSuffix(failure.Path, "*"))) {
+			continue
+		}
+		if failure.Times > 0 {
+			if failure.Times--; failure.Times == 0 {
+				server.failures = append(server.failures[:i:i], server.failures[i+1:]...)
+			}
+		}
+		return failure
+	}
+	return nil
+}
+
+// now é o método que retorna a data atual no formato das datas do MercadoPago (fuso horário GMT-4).
+func (server *Server) now() string {
+	return server.Now().In(time.FixedZone("GMT-4", -4*60*60)).Format(TimeLayout)
+}
+
+// match é a função que indica se o caminho começa com os segmentos informados.
+func match(segments []string, prefix ...string) bool {
+	if len(segments) < len(prefix) {
+		return false
+	}
+	for i, segment := range prefix {
+		if segments[i] != segment {
+			return false
+		}
+	}
+	return true
+}
+
+// errorBody é a função que monta um erro no formato retornado pelo MercadoPago.
+func errorBody(status int, code, message string, causes ...Object) Object {
+	if causes == nil {
+		causes = []Object{}
+	}
+	return Object{"message": message, "error": code, "status": status, "cause": causes}
+}
+
+// writeError é a função que escreve um erro no formato retornado pelo MercadoPago.
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(errorBody(status, code, message))
+}
+
+// toObject é a função que converte qualquer struct ou map em Object, passando pelo JSON.
+func toObject(v interface{}) (Object, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var object Object
+	err = json.Unmarshal(data, &object)
+	return object, err
+}
+
+// copyObject é a função que retorna uma cópia profunda do objeto, evitando que o estado do servidor seja alterado fora do mutex.
+func copyObject(object Object) Object {
+	copied, _ := toObject(object)
+	return copied
+}
+
+// newUUID é a função que gera um identificador aleatório no formato UUID v4.
+func newUUID() string {
+	id := make([]byte, 16)
+	rand.Read(id)
+	id[6] = id[6]&0x0F | 0x40
+	id[8] = id[8]&0x3F | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:])
+}
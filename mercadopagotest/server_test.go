@@ -0,0 +1,245 @@
+package mercadopagotest
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/eduardo-mior/mercadopago-sdk-go/boleto"
+	"github.com/eduardo-mior/mercadopago-sdk-go/pix"
+)
+
+// do é a função auxiliar dos testes que faz uma requisição autenticada ao servidor e decodifica o JSON da resposta.
+func do(t *testing.T, server *Server, method, path string, body interface{}, headers map[string]string) (int, Object) {
+	t.Helper()
+
+	var reader *bytes.Reader
+	if body != nil {
+		data, _ := json.Marshal(body)
+		reader = bytes.NewReader(data)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	request, _ := http.NewRequest(method, server.URL+path, reader)
+	request.Header.Set("Authorization", "Bearer TEST-TOKEN")
+	for key, value := range headers {
+		request.Header.Set(key, value)
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	var object Object
+	json.NewDecoder(response.Body).Decode(&object)
+	return response.StatusCode, object
+}
+
+// Testando o ciclo de vida de uma preferência (criação, consulta, atualização e busca)
+func TestPreferences(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	status, _ := do(t, server, "POST", "/checkout/preferences", Object{"items": []Object{{"title": "Item sem preço", "quantity": 1}}}, nil)
+	if status != http.StatusBadRequest {
+		t.Error("Preferência sem preço unitário deveria ser rejeitada, status:", status)
+	}
+
+	status, preference := do(t, server, "POST", "/checkout/preferences", Object{
+		"external_reference": "test-00001",
+		"items":              []Object{{"title": "Mensalidade", "quantity": 1, "unit_price": 50}},
+		"payer":              Object{"email": "test@test.com"},
+	}, nil)
+	if status != http.StatusCreated {
+		t.Fatal("Erro ao criar a preferência, status:", status)
+	}
+	id := preference["id"].(string)
+	if preference["init_point"] == "" || id[:10] != strconv.FormatInt(server.CollectorID, 10)+"-" {
+		t.Error("Preferência criada sem os campos gerados:", preference)
+	}
+
+	status, preference = do(t, server, "PUT", "/checkout/preferences/"+id, Object{"items": []Object{{"title": "Semestralidade", "quantity": 1, "unit_price": 300}}}, nil)
+	if status != http.StatusOK || preference["external_reference"] != "test-00001" || lookup(preference, "id") != id {
+		t.Error("Erro ao atualizar a preferência:", status, preference)
+	}
+
+	_, search := do(t, server, "GET", "/checkout/preferences/search?external_reference=test-00001", nil, nil)
+	if search["total"] != 1.0 {
+		t.Error("Busca deveria retornar 1 preferência:", search)
+	}
+
+	status, _ = do(t, server, "GET", "/checkout/preferences/inexistente", nil, nil)
+	if status != http.StatusNotFound {
+		t.Error("Preferência inexistente deveria retornar 404, status:", status)
+	}
+}
+
+// Testando criação de pagamentos Pix e boleto com código Pix e código de barras válidos e a idempotência
+func TestPayments(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	body := Object{"transaction_amount": 10.5, "payment_method_id": "pix", "payer": Object{"email": "test@test.com"}}
+
+	status, _ := do(t, server, "POST", "/v1/payments", body, nil)
+	if status != http.StatusBadRequest {
+		t.Error("Pagamento sem X-Idempotency-Key deveria ser rejeitado, status:", status)
+	}
+
+	status, payment := do(t, server, "POST", "/v1/payments", body, map[string]string{"X-Idempotency-Key": "key-1"})
+	if status != http.StatusCreated || payment["status"] != "pending" {
+		t.Fatal("Erro ao criar o pagamento Pix:", status, payment)
+	}
+	brCode, err := pix.Parse(toString(lookup(payment, "point_of_interaction.transaction_data.qr_code")))
+	if err != nil {
+		t.Error("Código Pix inválido:", err)
+	} else if value, _ := brCode.Amount(); value != 1050 {
+		t.Error("Valor do código Pix inválido:", value)
+	}
+
+	_, repeated := do(t, server, "POST", "/v1/payments", body, map[string]string{"X-Idempotency-Key": "key-1"})
+	if repeated["id"] != payment["id"] {
+		t.Error("A mesma chave de idempotência deveria retornar o mesmo pagamento")
+	}
+
+	body["payment_method_id"] = "bolbradesco"
+	_, payment = do(t, server, "POST", "/v1/payments", body, map[string]string{"X-Idempotency-Key": "key-2"})
+	if err := boleto.ValidateBarcode(toString(lookup(payment, "barcode.content"))); err != nil {
+		t.Error("Código de barras inválido:", err)
+	}
+
+	id := toString(payment["id"])
+	status, payment = do(t, server, "PUT", "/v1/payments/"+id, Object{"status": "cancelled"}, nil)
+	if status != http.StatusOK || payment["status"] != "cancelled" {
+		t.Error("Erro ao cancelar o boleto:", status, payment)
+	}
+
+	_, search := do(t, server, "GET", "/v1/payments/search?status=pending", nil, nil)
+	if lookup(search, "paging.total") != 1.0 {
+		t.Error("Busca deveria retornar somente o Pix pendente:", search)
+	}
+}
+
+// Testando reembolsos parciais e totais de um pagamento aprovado
+func TestRefunds(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	id, err := server.AddPayment(Object{"transaction_amount": 100, "payment_method_id": "master"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := "/v1/payments/" + strconv.FormatInt(id, 10) + "/refunds"
+
+	status, _ := do(t, server, "POST", path, Object{"amount": 30.1}, nil)
+	if payment, _ := server.Payment(id); status != http.StatusCreated || payment["status"] != "approved" || payment["status_detail"] != "partially_refunded" {
+		t.Error("Erro no reembolso parcial:", status, payment)
+	}
+
+	status, _ = do(t, server, "POST", path, Object{"amount": 80}, nil)
+	if status != http.StatusBadRequest {
+		t.Error("Reembolso maior que o valor disponível deveria ser rejeitado, status:", status)
+	}
+
+	do(t, server, "POST", path, nil, nil)
+	if payment, _ := server.Payment(id); payment["status"] != "refunded" || payment["transaction_amount_refunded"] != 100.0 || len(payment["refunds"].([]interface{})) != 2 {
+		t.Error("Erro no reembolso total:", payment)
+	}
+}
+
+// Testando criação, consulta e busca de clientes, incluindo email duplicado
+func TestCustomers(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	status, customer := do(t, server, "POST", "/v1/customers", Object{"email": "test@test.com", "first_name": "Test"}, nil)
+	if status != http.StatusCreated {
+		t.Fatal("Erro ao criar o cliente:", status)
+	}
+
+	status, _ = do(t, server, "POST", "/v1/customers", Object{"email": "TEST@test.com"}, nil)
+	if status != http.StatusBadRequest {
+		t.Error("Cliente com email duplicado deveria ser rejeitado, status:", status)
+	}
+
+	_, search := do(t, server, "GET", "/v1/customers/search?email=test@test.com", nil, nil)
+	if lookup(search, "paging.total") != 1.0 {
+		t.Error("Busca deveria retornar 1 cliente:", search)
+	}
+
+	status, customer = do(t, server, "PUT", "/v1/customers/"+customer["id"].(string), Object{"last_name": "Silva"}, nil)
+	if status != http.StatusOK || customer["first_name"] != "Test" || customer["last_name"] != "Silva" {
+		t.Error("Erro ao atualizar o cliente:", status, customer)
+	}
+}
+
+// Testando falhas simuladas (status HTTP, JSON malformado e latência) e o access token obrigatório
+func TestFailures(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	server.FailNext("GET", "/v1/identification_types", http.StatusInternalServerError)
+	if status, body := do(t, server, "GET", "/v1/identification_types", nil, nil); status != http.StatusInternalServerError || body["status"] != 500.0 {
+		t.Error("Falha simulada não retornada:", status, body)
+	}
+	if status, _ := do(t, server, "GET", "/v1/identification_types", nil, nil); status != http.StatusOK {
+		t.Error("Falha simulada deveria acontecer somente uma vez, status:", status)
+	}
+
+	server.MalformedJSON("GET", "/v1/payments/*")
+	response, err := http.Get(server.URL + "/v1/payments/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var object Object
+	if err := json.NewDecoder(response.Body).Decode(&object); err == nil {
+		t.Error("JSON malformado deveria retornar erro na decodificação")
+	}
+	response.Body.Close()
+
+	server.Delay("", "", 50*time.Millisecond)
+	start := time.Now()
+	do(t, server, "GET", "/v1/payment_methods", nil, nil)
+	if time.Since(start) < 50*time.Millisecond {
+		t.Error("Latência simulada não aplicada")
+	}
+	server.ClearFailures()
+
+	request, _ := http.NewRequest("GET", server.URL+"/v1/payment_methods", nil)
+	response, err = http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusUnauthorized {
+		t.Error("Requisição sem access token deveria retornar 401, status:", response.StatusCode)
+	}
+
+	if requests := server.Requests(); len(requests) != 5 || requests[0].Path != "/v1/identification_types" {
+		t.Error("Requisições recebidas não registradas:", len(requests))
+	}
+}
+
+// Testando definição e restauração da variavel de ambiente MERCADO_PAGO_BASE_URL
+func TestSetenv(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	os.Setenv(BaseURLEnv, "https://example.com")
+	restore := server.Setenv()
+	if os.Getenv(BaseURLEnv) != server.URL {
+		t.Error("Variavel de ambiente não definida")
+	}
+	restore()
+	if os.Getenv(BaseURLEnv) != "https://example.com" {
+		t.Error("Variavel de ambiente não restaurada")
+	}
+	os.Unsetenv(BaseURLEnv)
+}
@@ -0,0 +1,195 @@
+package mercadopagotest
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrPaymentNotFound é o erro retornado pelo simulador quando o pagamento não existe no servidor.
+	ErrPaymentNotFound = errors.New("mercadopagotest: pagamento não encontrado")
+	// ErrWebhookFailed é o erro retornado pelo simulador quando a notification_url não respondeu com sucesso (status 2xx).
+	ErrWebhookFailed = errors.New("mercadopagotest: falha ao enviar o webhook")
+)
+
+// CardholderResult é a struct que contém o status e o detalhe do status de um pagamento com cartão de teste de acordo com o nome do titular.
+type CardholderResult struct {
+	Status       string // Status do pagamento (exemplo: approved)
+	StatusDetail string // Detalhe do status do pagamento (exemplo: accredited)
+}
+
+// TestCardholders são os nomes dos titulares dos cartões de teste do MercadoPago e o resultado que cada um produz no pagamento.
+// O nome é lido do card.cardholder.name ou, quando não informado, do payer.first_name. Pagamentos com cartão sem nenhum desses nomes são aprovados.
+var TestCardholders = map[string]CardholderResult{
+	"APRO": {"approved", "accredited"},                           // Pagamento aprovado
+	"OTHE": {"rejected", "cc_rejected_other_reason"},             // Recusado por erro geral
+	"CONT": {"in_process", "pending_contingency"},                // Pagamento pendente
+	"CALL": {"rejected", "cc_rejected_call_for_authorize"},       // Recusado com validação para autorizar
+	"FUND": {"rejected", "cc_rejected_insufficient_amount"},      // Recusado por saldo insuficiente
+	"SECU": {"rejected", "cc_rejected_bad_filled_security_code"}, // Recusado por código de segurança inválido
+	"EXPI": {"rejected", "cc_rejected_bad_filled_date"},          // Recusado por problema com a data de vencimento
+	"FORM": {"rejected", "cc_rejected_bad_filled_other"},         // Recusado por erro no formulário
+}
+
+// Webhook é a struct que contém as informações de um webhook enviado pelo simulador de pagamentos.
+type Webhook struct {
+	URL        string      // URL completa que recebeu o webhook (com os parametros data.id e type)
+	Headers    http.Header // Headers enviados (x-signature e x-request-id)
+	Body       []byte      // Body enviado, no mesmo formato do MercadoPago
+	StatusCode int         // Status HTTP respondido pela notification_url (zero quando a requisição falhou)
+	Err        error       // Erro no envio do webhook
+}
+
+// ApprovePayment é o método que aprova um pagamento (exemplo: simular o pagamento de um Pix ou de um boleto) e envia o webhook payment.updated.
+func (server *Server) ApprovePayment(id int64) error {
+	return server.UpdatePaymentStatus(id, "approved", "accredited")
+}
+
+// RejectPayment é o método que rejeita um pagamento com o detalhe do status informado (vazio para cc_rejected_other_reason) e envia o webhook payment.updated.
+func (server *Server) RejectPayment(id int64, statusDetail string) error {
+	if statusDetail == "" {
+		statusDetail = "cc_rejected_other_reason"
+	}
+	return server.UpdatePaymentStatus(id, "rejected", statusDetail)
+}
+
+// RefundPayment é o método que reembolsa todo o valor de um pagamento, registrando o reembolso, e envia o webhook payment.updated.
+func (server *Server) RefundPayment(id int64) error {
+	return server.UpdatePaymentStatus(id, "refunded", "refunded")
+}
+
+// ChargeBackPayment é o método que estorna um pagamento (chargeback do cartão) e envia o webhook payment.updated.
+func (server *Server) ChargeBackPayment(id int64) error {
+	return server.UpdatePaymentStatus(id, "charged_back", "settled")
+}
+
+// UpdatePaymentStatus é o método que altera o status e o detalhe do status de um pagamento e envia o webhook payment.updated para a notification_url
+// do pagamento (ou para a NotificationURL do servidor). As transições não são validadas, permitindo simular também atualizações impossíveis.
+// Quando não existe nenhuma URL de notificação o status é alterado sem enviar o webhook. O webhook é enviado antes do retorno do método,
+// e caso a notification_url não responda com sucesso então retorna ErrWebhookFailed.
+func (server *Server) UpdatePaymentStatus(id int64, status, statusDetail string) error {
+	server.mutex.Lock()
+	payment, ok := server.payments[id]
+	if !ok {
+		server.mutex.Unlock()
+		return ErrPaymentNotFound
+	}
+
+	if status == "refunded" && toString(payment["status"]) == "approved" {
+		server.createRefund(payment, Object{})
+	}
+	payment["status"], payment["status_detail"] = status, statusDetail
+	payment["date_last_updated"] = server.now()
+	if status == "approved" && payment["date_approved"] == nil {
+		payment["date_approved"] = payment["date_last_updated"]
+	}
+
+	notificationURL := toString(payment["notification_url"])
+	if notificationURL == "" {
+		notificationURL = server.NotificationURL
+	}
+	server.mutex.Unlock()
+
+	if notificationURL == "" {
+		return nil
+	}
+	return server.SendWebhook(notificationURL, id, "payment.updated")
+}
+
+// SendWebhook é o método que envia um webhook de pagamento para a URL informada, no mesmo formato e com a mesma assinatura (x-signature) do MercadoPago.
+func (server *Server) SendWebhook(notificationURL string, paymentID int64, action string) error {
+	dataID := strconv.FormatInt(paymentID, 10)
+	requestID := newUUID()
+	timestamp := server.Now().UnixNano() / int64(time.Millisecond)
+
+	server.mutex.Lock()
+	webhookID := server.nextWebhookID
+	server.nextWebhookID++
+	server.mutex.Unlock()
+
+	body, _ := json.Marshal(Object{
+		"id":           webhookID,
+		"live_mode":    false,
+		"type":         "payment",
+		"date_created": server.now(),
+		"user_id":      strconv.FormatInt(server.CollectorID, 10),
+		"api_version":  "v1",
+		"action":       action,
+		"data":         Object{"id": dataID},
+	})
+
+	webhookURL, err := url.Parse(notificationURL)
+	if err != nil {
+		return err
+	}
+	query := webhookURL.Query()
+	query.Set("data.id", dataID)
+	query.Set("type", "payment")
+	webhookURL.RawQuery = query.Encode()
+
+	request, err := http.NewRequest(http.MethodPost, webhookURL.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Request-Id", requestID)
+	request.Header.Set("X-Signature", Signature(server.WebhookSecret, dataID, requestID, timestamp))
+
+	webhook := Webhook{URL: webhookURL.String(), Headers: request.Header.Clone(), Body: body}
+	response, err := (&http.Client{Timeout: 10 * time.Second}).Do(request)
+	if err != nil {
+		webhook.Err = err
+	} else {
+		ioutil.ReadAll(response.Body)
+		response.Body.Close()
+		webhook.StatusCode = response.StatusCode
+		if response.StatusCode < 200 || response.StatusCode > 299 {
+			webhook.Err = fmt.Errorf("%w: status %d", ErrWebhookFailed, response.StatusCode)
+		}
+	}
+
+	server.mutex.Lock()
+	server.webhooks = append(server.webhooks, webhook)
+	server.mutex.Unlock()
+	return webhook.Err
+}
+
+// Webhooks é o método que retorna todos os webhooks enviados pelo simulador, na ordem em que foram enviados.
+func (server *Server) Webhooks() []Webhook {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	return append([]Webhook(nil), server.webhooks...)
+}
+
+// Signature é a função que gera o valor do header x-signature (ts=...,v1=...) de um webhook, assinando com HMAC-SHA256 o manifesto
+// id:{data.id};request-id:{x-request-id};ts:{ts}; com a assinatura secreta, da mesma forma que o MercadoPago.
+// O timestamp é informado em milissegundos.
+func Signature(secret, dataID, requestID string, timestamp int64) string {
+	ts := strconv.FormatInt(timestamp, 10)
+	manifest := "id:" + strings.ToLower(dataID) + ";request-id:" + requestID + ";ts:" + ts + ";"
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(manifest))
+	return "ts=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// cardholderResult é a função que retorna o resultado do cartão de teste de acordo com o nome do titular informado no pagamento.
+func cardholderResult(payment Object) (CardholderResult, bool) {
+	name := toString(lookup(payment, "card.cardholder.name"))
+	if name == "" {
+		name = toString(lookup(payment, "payer.first_name"))
+	}
+	result, ok := TestCardholders[strings.ToUpper(strings.TrimSpace(name))]
+	return result, ok
+}
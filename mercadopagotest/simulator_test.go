@@ -0,0 +1,117 @@
+package mercadopagotest
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// Testando os resultados dos cartões de teste de acordo com o nome do titular
+func TestTestCardholders(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	tests := map[string]string{"APRO": "approved", "OTHE": "rejected", "CONT": "in_process", "FUND": "rejected", "": "approved"}
+	for name, status := range tests {
+		_, payment := do(t, server, "POST", "/v1/payments", Object{
+			"transaction_amount": 100,
+			"payment_method_id":  "master",
+			"payer":              Object{"email": "test@test.com"},
+			"card":               Object{"cardholder": Object{"name": name}},
+		}, map[string]string{"X-Idempotency-Key": "key-" + name})
+
+		if payment["status"] != status {
+			t.Error("Status incorreto para o titular "+name+":", payment["status"], payment["status_detail"])
+		}
+	}
+
+	_, payment := do(t, server, "POST", "/v1/payments", Object{
+		"transaction_amount": 100,
+		"payment_method_id":  "visa",
+		"payer":              Object{"email": "test@test.com", "first_name": "FUND"},
+	}, map[string]string{"X-Idempotency-Key": "key-payer"})
+	if payment["status_detail"] != "cc_rejected_insufficient_amount" {
+		t.Error("Nome do titular não lido do payer.first_name:", payment["status_detail"])
+	}
+}
+
+// Testando alteração de status pelo simulador e envio do webhook com a assinatura x-signature válida
+func TestUpdatePaymentStatus(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	var received []Object
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		parts := map[string]string{}
+		for _, part := range strings.Split(r.Header.Get("X-Signature"), ",") {
+			if pair := strings.SplitN(part, "=", 2); len(pair) == 2 {
+				parts[pair[0]] = pair[1]
+			}
+		}
+
+		mac := hmac.New(sha256.New, []byte(server.WebhookSecret))
+		mac.Write([]byte("id:" + r.URL.Query().Get("data.id") + ";request-id:" + r.Header.Get("X-Request-Id") + ";ts:" + parts["ts"] + ";"))
+		if hex.EncodeToString(mac.Sum(nil)) != parts["v1"] {
+			t.Error("Assinatura x-signature inválida:", r.Header.Get("X-Signature"))
+		}
+
+		var webhook Object
+		data, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(data, &webhook)
+		received = append(received, webhook)
+	}))
+	defer receiver.Close()
+
+	id, _ := server.AddPayment(Object{"transaction_amount": 50, "payment_method_id": "pix", "status": "pending", "status_detail": "pending_waiting_transfer", "notification_url": receiver.URL + "/webhook"})
+
+	if err := server.ApprovePayment(id); err != nil {
+		t.Fatal(err)
+	}
+	if err := server.RefundPayment(id); err != nil {
+		t.Fatal(err)
+	}
+
+	payment, _ := server.Payment(id)
+	if payment["status"] != "refunded" || payment["transaction_amount_refunded"] != 50.0 || payment["date_approved"] == nil {
+		t.Error("Status do pagamento alterado incorretamente:", payment)
+	}
+
+	if len(received) != 2 || received[0]["action"] != "payment.updated" || lookup(received[1], "data.id") != toString(id) {
+		t.Error("Webhooks recebidos incorretamente:", received)
+	}
+	if webhooks := server.Webhooks(); len(webhooks) != 2 || webhooks[0].StatusCode != http.StatusOK || !strings.Contains(webhooks[0].URL, "type=payment") {
+		t.Error("Webhooks enviados registrados incorretamente:", webhooks)
+	}
+
+	if err := server.ChargeBackPayment(999); err != ErrPaymentNotFound {
+		t.Error("Pagamento inexistente deveria retornar ErrPaymentNotFound:", err)
+	}
+}
+
+// Testando erro no envio do webhook quando a notification_url não responde com sucesso
+func TestWebhookFailed(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer receiver.Close()
+
+	server.NotificationURL = receiver.URL
+	id, _ := server.AddPayment(Object{"transaction_amount": 50, "payment_method_id": "master"})
+
+	if err := server.ChargeBackPayment(id); !errors.Is(err, ErrWebhookFailed) {
+		t.Error("Falha no webhook não retornada:", err)
+	}
+	if payment, _ := server.Payment(id); payment["status"] != "charged_back" {
+		t.Error("Status deveria ser alterado mesmo com falha no webhook:", payment["status"])
+	}
+}
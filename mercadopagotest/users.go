@@ -0,0 +1,73 @@
+package mercadopagotest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Sites são os IDs dos sites (países) em que o MercadoPago opera e em que é possível criar usuários de testes.
+var Sites = []string{"MLA", "MLB", "MLM", "MLC", "MCO", "MPE", "MLU"}
+
+// TestUsers é o método que retorna uma cópia dos usuários de testes criados no servidor, na ordem em que foram criados.
+func (server *Server) TestUsers() []Object {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	testUsers := make([]Object, len(server.testUsers))
+	for i, testUser := range server.testUsers {
+		testUsers[i] = copyObject(testUser)
+	}
+	return testUsers
+}
+
+// usersRoute é o método que trata as requisições de criação de usuários de testes (/users/test) e da conta dona do access token (/users/me).
+// A conta dona do access token é sempre a conta do vendedor do servidor (CollectorID).
+func (server *Server) usersRoute(method, resource string, data Object) (int, interface{}) {
+	switch {
+	case resource == "test" && method == http.MethodPost:
+		siteID := toString(data["site_id"])
+		valid := false
+		for _, site := range Sites {
+			valid = valid || site == siteID
+		}
+		if !valid {
+			return http.StatusBadRequest, errorBody(http.StatusBadRequest, "bad_request", "invalid site_id "+siteID)
+		}
+
+		id := server.nextTestUserID
+		server.nextTestUserID++
+
+		nickname := "TESTUSER" + strconv.FormatInt(id, 10)
+		testUser := Object{
+			"id":          id,
+			"nickname":    nickname,
+			"password":    strings.ReplaceAll(newUUID(), "-", "")[:10],
+			"email":       "test_user_" + strconv.FormatInt(id, 10) + "@testuser.com",
+			"site_status": "active",
+			"site_id":     siteID,
+			"description": toString(data["description"]),
+		}
+		server.testUsers = append(server.testUsers, testUser)
+		return http.StatusCreated, testUser
+
+	case resource == "me" && method == http.MethodGet:
+		id := strconv.FormatInt(server.CollectorID, 10)
+		return http.StatusOK, Object{
+			"id":                server.CollectorID,
+			"nickname":          "TESTUSER" + id,
+			"first_name":        "Test",
+			"last_name":         "Test",
+			"email":             "test_user_" + id + "@testuser.com",
+			"country_id":        "BR",
+			"site_id":           "MLB",
+			"user_type":         "normal",
+			"identification":    Object{"type": "CPF", "number": "19119119100"},
+			"tags":              []string{"normal", "test_user"},
+			"permalink":         "http://perfil.mercadolivre.com.br/TESTUSER" + id,
+			"registration_date": server.now(),
+			"status":            Object{"site_status": "active"},
+		}
+	}
+
+	return http.StatusNotFound, errorBody(http.StatusNotFound, "not_found", "user "+resource+" not found")
+}
@@ -0,0 +1,393 @@
+package mercadopago
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+)
+
+// Idiomas suportados pelo catálogo de mensagens
+const (
+	LanguagePortuguese = "pt"
+	LanguageSpanish    = "es"
+	LanguageEnglish    = "en"
+)
+
+// RecommendedAction é o tipo que indica qual ação é recomendada ao pagador após uma rejeição ou erro.
+type RecommendedAction string
+
+// Ações recomendadas ao pagador
+const (
+	ActionNone           RecommendedAction = "none"            // Nenhuma ação necessária
+	ActionWait           RecommendedAction = "wait"            // Aguardar o processamento do pagamento
+	ActionRetry          RecommendedAction = "retry"           // Tentar novamente com os mesmos dados
+	ActionFixData        RecommendedAction = "fix_data"        // Corrigir os dados informados e tentar novamente
+	ActionChangeCard     RecommendedAction = "change_card"     // Usar outro cartão ou outro meio de pagamento
+	ActionCallIssuer     RecommendedAction = "call_issuer"     // Entrar em contato com o banco emissor do cartão para autorizar o pagamento
+	ActionContactSupport RecommendedAction = "contact_support" // Entrar em contato com o vendedor (erro de integração)
+)
+
+// LocalizedMessage é a struct que contém uma mensagem para o pagador em português, espanhol e inglês e a ação recomendada.
+// As mensagens podem conter as variáveis {payment_method} e {amount}, que são substituídas pelos dados do pagamento no método PaymentMessage.
+type LocalizedMessage struct {
+	PT     string            // Mensagem em português
+	ES     string            // Mensagem em espanhol
+	EN     string            // Mensagem em inglês
+	Action RecommendedAction // Ação recomendada ao pagador
+}
+
+// Text é o método que retorna a mensagem no idioma informado (pt, es, en, ou com região como pt-BR e es-AR).
+// Caso o idioma não seja suportado ou a mensagem não exista no idioma então retorna a mensagem em português.
+func (localizedMessage LocalizedMessage) Text(language string) string {
+	var text string
+	switch strings.ToLower(strings.SplitN(strings.Replace(language, "_", "-", 1), "-", 2)[0]) {
+	case LanguageSpanish:
+		text = localizedMessage.ES
+	case LanguageEnglish:
+		text = localizedMessage.EN
+	}
+	if text == "" {
+		text = localizedMessage.PT
+	}
+	return text
+}
+
+var (
+	messagesMutex sync.RWMutex
+
+	// statusDetailMessages contém as mensagens de cada detalhe de status de pagamento.
+	// As mensagens foram baseadas nas mensagens recomendadas pela documentação do MercadoPago.
+	statusDetailMessages = map[StatusDetail]LocalizedMessage{
+		StatusDetailAccredited: {
+			PT: "Pronto, seu pagamento foi aprovado!", ES: "¡Listo! Se acreditó tu pago.", EN: "Done, your payment was approved!", Action: ActionNone,
+		},
+		StatusDetailPartiallyRefunded: {
+			PT: "Parte do valor do seu pagamento foi devolvida.", ES: "Se devolvió parte del monto de tu pago.", EN: "Part of your payment was refunded.", Action: ActionNone,
+		},
+		StatusDetailPendingContingency: {
+			PT: "Estamos processando o pagamento. Em até 2 dias úteis informaremos o resultado.", ES: "Estamos procesando tu pago. En menos de 2 días hábiles te avisaremos si se acreditó.", EN: "We are processing your payment. You will be notified of the result within 2 business days.", Action: ActionWait,
+		},
+		StatusDetailPendingReviewManual: {
+			PT: "Estamos processando o pagamento. Em até 2 dias úteis informaremos se foi aprovado ou se precisamos de mais informações.", ES: "Estamos procesando tu pago. En menos de 2 días hábiles te diremos si se acreditó o si necesitamos más información.", EN: "We are processing your payment. Within 2 business days we will tell you whether it was approved or if we need more information.", Action: ActionWait,
+		},
+		StatusDetailPendingWaitingPayment: {
+			PT: "Aguardando o pagamento do boleto.", ES: "Esperando el pago del cupón.", EN: "Waiting for the payment slip to be paid.", Action: ActionWait,
+		},
+		StatusDetailPendingWaitingTransfer: {
+			PT: "Aguardando o pagamento do Pix.", ES: "Esperando la transferencia.", EN: "Waiting for the transfer to be completed.", Action: ActionWait,
+		},
+		StatusDetailPendingCapture: {
+			PT: "O pagamento foi autorizado e esta aguardando a confirmação.", ES: "El pago fue autorizado y está esperando la confirmación.", EN: "The payment was authorized and is waiting for confirmation.", Action: ActionWait,
+		},
+		StatusDetailPendingChallenge: {
+			PT: "Conclua a autenticação do seu cartão para finalizar o pagamento.", ES: "Completa la autenticación de tu tarjeta para finalizar el pago.", EN: "Complete your card authentication to finish the payment.", Action: ActionFixData,
+		},
+		StatusDetailOfflineProcess: {
+			PT: "Estamos processando o pagamento. Em breve informaremos o resultado.", ES: "Estamos procesando tu pago. En breve te avisaremos el resultado.", EN: "We are processing your payment. You will be notified of the result shortly.", Action: ActionWait,
+		},
+		StatusDetailCCRejectedBadFilledCardNumber: {
+			PT: "Revise o número do cartão.", ES: "Revisa el número de tarjeta.", EN: "Check the card number.", Action: ActionFixData,
+		},
+		StatusDetailCCRejectedBadFilledDate: {
+			PT: "Revise a data de vencimento.", ES: "Revisa la fecha de vencimiento.", EN: "Check the expiration date.", Action: ActionFixData,
+		},
+		StatusDetailCCRejectedBadFilledOther: {
+			PT: "Revise os dados do cartão.", ES: "Revisa los datos de la tarjeta.", EN: "Check the card details.", Action: ActionFixData,
+		},
+		StatusDetailCCRejectedBadFilledSecurityCode: {
+			PT: "Revise o código de segurança do cartão.", ES: "Revisa el código de seguridad de la tarjeta.", EN: "Check the card security code.", Action: ActionFixData,
+		},
+		StatusDetailCCRejectedBlacklist: {
+			PT: "Não pudemos processar seu pagamento. Use outro cartão ou outro meio de pagamento.", ES: "No pudimos procesar tu pago. Usa otra tarjeta u otro medio de pago.", EN: "We could not process your payment. Use another card or payment method.", Action: ActionChangeCard,
+		},
+		StatusDetailCCRejectedCallForAuthorize: {
+			PT: "Você deve autorizar ao {payment_method} o pagamento do valor de {amount}.", ES: "Debes autorizar ante {payment_method} el pago de {amount}.", EN: "You must authorize the payment of {amount} with {payment_method}.", Action: ActionCallIssuer,
+		},
+		StatusDetailCCRejectedCardDisabled: {
+			PT: "Ligue para o {payment_method} para ativar seu cartão. O telefone está no verso do seu cartão.", ES: "Llama a {payment_method} para activar tu tarjeta o usa otro medio de pago. El teléfono está al dorso de tu tarjeta.", EN: "Call {payment_method} to activate your card or use another payment method. The phone number is on the back of your card.", Action: ActionCallIssuer,
+		},
+		StatusDetailCCRejectedCardError: {
+			PT: "Não conseguimos processar seu pagamento.", ES: "No pudimos procesar tu pago.", EN: "We could not process your payment.", Action: ActionRetry,
+		},
+		StatusDetailCCRejectedDuplicatedPayment: {
+			PT: "Você já efetuou um pagamento com esse valor. Caso precise pagar novamente, utilize outro cartão ou outra forma de pagamento.", ES: "Ya hiciste un pago por ese valor. Si necesitas volver a pagar usa otra tarjeta u otro medio de pago.", EN: "You already made a payment for this amount. If you need to pay again, use another card or payment method.", Action: ActionChangeCard,
+		},
+		StatusDetailCCRejectedHighRisk: {
+			PT: "Seu pagamento foi recusado. Escolha outra forma de pagamento. Recomendamos meios de pagamento em dinheiro.", ES: "Tu pago fue rechazado. Elige otro de los medios de pago, te recomendamos con medios en efectivo.", EN: "Your payment was declined. Choose another payment method. We recommend cash payment methods.", Action: ActionChangeCard,
+		},
+		StatusDetailCCRejectedInsufficientAmount: {
+			PT: "O {payment_method} possui saldo insuficiente.", ES: "Tu {payment_method} no tiene fondos suficientes.", EN: "Your {payment_method} has insufficient funds.", Action: ActionChangeCard,
+		},
+		StatusDetailCCRejectedInvalidInstallments: {
+			PT: "O {payment_method} não processa pagamentos parcelados nessa quantidade de parcelas.", ES: "{payment_method} no procesa pagos en esa cantidad de cuotas.", EN: "{payment_method} does not process payments in this number of installments.", Action: ActionFixData,
+		},
+		StatusDetailCCRejectedMaxAttempts: {
+			PT: "Você atingiu o limite de tentativas permitido. Escolha outro cartão ou outra forma de pagamento.", ES: "Llegaste al límite de intentos permitidos. Elige otra tarjeta u otro medio de pago.", EN: "You have reached the limit of allowed attempts. Choose another card or payment method.", Action: ActionChangeCard,
+		},
+		StatusDetailCCRejectedOtherReason: {
+			PT: "O {payment_method} não processou seu pagamento.", ES: "{payment_method} no procesó el pago.", EN: "{payment_method} did not process the payment.", Action: ActionChangeCard,
+		},
+		StatusDetailCCRejectedCardTypeNotAllowed: {
+			PT: "O pagamento foi recusado porque o seu cartão não permite o método de pagamento selecionado. Escolha outro cartão ou outra forma de pagamento.", ES: "El pago fue rechazado porque tu tarjeta no permite el medio de pago seleccionado. Elige otra tarjeta u otro medio de pago.", EN: "The payment was declined because your card does not allow the selected payment method. Choose another card or payment method.", Action: ActionChangeCard,
+		},
+		StatusDetailCCRejected3DSMandatory: {
+			PT: "O pagamento foi recusado porque não foi possível autenticar o cartão. Tente novamente ou escolha outro cartão.", ES: "El pago fue rechazado porque no se pudo autenticar la tarjeta. Intenta nuevamente o elige otra tarjeta.", EN: "The payment was declined because the card could not be authenticated. Try again or choose another card.", Action: ActionRetry,
+		},
+		StatusDetailCCRejected3DSChallenge: {
+			PT: "O pagamento foi recusado porque a autenticação do cartão não foi concluída. Tente novamente.", ES: "El pago fue rechazado porque no se completó la autenticación de la tarjeta. Intenta nuevamente.", EN: "The payment was declined because the card authentication was not completed. Try again.", Action: ActionRetry,
+		},
+		StatusDetailCCAmountRateLimitExceeded: {
+			PT: "O pagamento foi recusado porque ultrapassou o limite do meio de pagamento. Escolha outro cartão ou outra forma de pagamento.", ES: "El pago fue rechazado porque superó el límite del medio de pago. Elige otra tarjeta u otro medio de pago.", EN: "The payment was declined because it exceeded the payment method limit. Choose another card or payment method.", Action: ActionChangeCard,
+		},
+		StatusDetailRejectedHighRisk: {
+			PT: "Seu pagamento foi recusado. Escolha outra forma de pagamento.", ES: "Tu pago fue rechazado. Elige otro medio de pago.", EN: "Your payment was declined. Choose another payment method.", Action: ActionChangeCard,
+		},
+		StatusDetailRejectedInsufficientData: {
+			PT: "Seu pagamento foi recusado por falta de informações. Revise seus dados e tente novamente.", ES: "Tu pago fue rechazado por falta de información. Revisa tus datos e intenta nuevamente.", EN: "Your payment was declined due to missing information. Check your details and try again.", Action: ActionFixData,
+		},
+		StatusDetailRejectedByBank: {
+			PT: "O banco recusou o pagamento. Escolha outra forma de pagamento.", ES: "El banco rechazó el pago. Elige otro medio de pago.", EN: "The bank declined the payment. Choose another payment method.", Action: ActionChangeCard,
+		},
+		StatusDetailRejectedByRegulations: {
+			PT: "O pagamento foi recusado por questões regulatórias.", ES: "El pago fue rechazado por cuestiones regulatorias.", EN: "The payment was declined due to regulatory reasons.", Action: ActionContactSupport,
+		},
+		StatusDetailByCollector: {
+			PT: "O pagamento foi cancelado pelo vendedor.", ES: "El pago fue cancelado por el vendedor.", EN: "The payment was cancelled by the seller.", Action: ActionContactSupport,
+		},
+		StatusDetailByPayer: {
+			PT: "O pagamento foi cancelado.", ES: "El pago fue cancelado.", EN: "The payment was cancelled.", Action: ActionNone,
+		},
+		StatusDetailByAdmin: {
+			PT: "O pagamento foi cancelado pelo MercadoPago.", ES: "El pago fue cancelado por Mercado Pago.", EN: "The payment was cancelled by Mercado Pago.", Action: ActionContactSupport,
+		},
+		StatusDetailExpired: {
+			PT: "O prazo para pagamento expirou. Gere um novo pagamento.", ES: "El plazo para pagar venció. Genera un nuevo pago.", EN: "The payment deadline has expired. Create a new payment.", Action: ActionRetry,
+		},
+		StatusDetailRefunded: {
+			PT: "O valor do pagamento foi devolvido.", ES: "Se devolvió el monto del pago.", EN: "The payment was refunded.", Action: ActionNone,
+		},
+		StatusDetailBPPRefunded: {
+			PT: "O valor do pagamento foi devolvido pela proteção ao comprador.", ES: "El Programa de Protección al Comprador devolvió el monto del pago.", EN: "The payment was refunded by the buyer protection program.", Action: ActionNone,
+		},
+		StatusDetailInProcess: {
+			PT: "O pagamento foi contestado e está em análise.", ES: "El pago fue desconocido y está en revisión.", EN: "The payment was disputed and is under review.", Action: ActionWait,
+		},
+		StatusDetailSettled: {
+			PT: "A contestação do pagamento foi resolvida.", ES: "Se resolvió el desconocimiento del pago.", EN: "The payment dispute was settled.", Action: ActionNone,
+		},
+		StatusDetailReimbursed: {
+			PT: "A contestação do pagamento foi resolvida e o valor foi devolvido ao vendedor.", ES: "Se resolvió el desconocimiento del pago y el monto fue devuelto al vendedor.", EN: "The payment dispute was resolved and the amount was returned to the seller.", Action: ActionNone,
+		},
+	}
+
+	// errorCauseMessages contém as mensagens dos códigos das causas dos erros retornados pela API de pagamentos.
+	errorCauseMessages = map[string]LocalizedMessage{
+		"106": {
+			PT: "Não é possível efetuar pagamentos para usuários de outros países.", ES: "No puedes realizar pagos a usuarios de otros países.", EN: "You cannot make payments to users from other countries.", Action: ActionContactSupport,
+		},
+		"109": {
+			PT: "O {payment_method} não processa pagamentos parcelados. Escolha outro cartão ou outra forma de pagamento.", ES: "{payment_method} no procesa pagos en cuotas. Elige otra tarjeta u otro medio de pago.", EN: "{payment_method} does not process installment payments. Choose another card or payment method.", Action: ActionChangeCard,
+		},
+		"126": {
+			PT: "Não conseguimos processar seu pagamento.", ES: "No pudimos procesar tu pago.", EN: "We could not process your payment.", Action: ActionRetry,
+		},
+		"129": {
+			PT: "O {payment_method} não processa pagamentos para o valor selecionado. Escolha outro cartão ou outra forma de pagamento.", ES: "{payment_method} no procesa pagos del monto seleccionado. Elige otra tarjeta u otro medio de pago.", EN: "{payment_method} does not process payments for the selected amount. Choose another card or payment method.", Action: ActionChangeCard,
+		},
+		"145": {
+			PT: "Uma das partes com a qual está tentando realizar o pagamento é um usuário de teste e a outra é um usuário real.", ES: "Una de las partes con la que intentas hacer el pago es de prueba y la otra es usuario real.", EN: "One of the parties in the payment is a test user and the other is a real user.", Action: ActionContactSupport,
+		},
+		"150": {
+			PT: "Você não pode efetuar pagamentos.", ES: "No puedes realizar pagos.", EN: "You cannot make payments.", Action: ActionContactSupport,
+		},
+		"151": {
+			PT: "Você não pode efetuar pagamentos.", ES: "No puedes realizar pagos.", EN: "You cannot make payments.", Action: ActionContactSupport,
+		},
+		"160": {
+			PT: "Não conseguimos processar seu pagamento.", ES: "No pudimos procesar tu pago.", EN: "We could not process your payment.", Action: ActionRetry,
+		},
+		"204": {
+			PT: "O {payment_method} não está disponível nesse momento. Escolha outro cartão ou outra forma de pagamento.", ES: "{payment_method} no está disponible en este momento. Elige otra tarjeta u otro medio de pago.", EN: "{payment_method} is not available at this time. Choose another card or payment method.", Action: ActionChangeCard,
+		},
+		"801": {
+			PT: "Você realizou um pagamento similar há poucos instantes. Tente novamente em alguns minutos.", ES: "Realizaste un pago similar hace instantes. Intenta nuevamente en unos minutos.", EN: "You made a similar payment a moment ago. Try again in a few minutes.", Action: ActionRetry,
+		},
+		"2067": {
+			PT: "Revise o número do documento de identificação.", ES: "Revisa el número de documento de identidad.", EN: "Check the identification document number.", Action: ActionFixData,
+		},
+		"3034": {
+			PT: "Revise os dados do cartão.", ES: "Revisa los datos de la tarjeta.", EN: "Check the card details.", Action: ActionFixData,
+		},
+		"205": {
+			PT: "Digite o número do seu cartão.", ES: "Ingresa el número de tu tarjeta.", EN: "Enter your card number.", Action: ActionFixData,
+		},
+		"208": {
+			PT: "Escolha um mês.", ES: "Elige un mes.", EN: "Choose a month.", Action: ActionFixData,
+		},
+		"209": {
+			PT: "Escolha um ano.", ES: "Elige un año.", EN: "Choose a year.", Action: ActionFixData,
+		},
+		"212": {
+			PT: "Informe seu documento.", ES: "Ingresa tu documento.", EN: "Enter your document.", Action: ActionFixData,
+		},
+		"214": {
+			PT: "Informe seu documento.", ES: "Ingresa tu documento.", EN: "Enter your document.", Action: ActionFixData,
+		},
+		"220": {
+			PT: "Informe seu banco emissor.", ES: "Ingresa tu banco emisor.", EN: "Enter your card issuer.", Action: ActionFixData,
+		},
+		"221": {
+			PT: "Informe o nome e sobrenome.", ES: "Ingresa el nombre y apellido.", EN: "Enter the first and last name.", Action: ActionFixData,
+		},
+		"224": {
+			PT: "Preencha o código de segurança.", ES: "Ingresa el código de seguridad.", EN: "Enter the security code.", Action: ActionFixData,
+		},
+		"E301": {
+			PT: "Há algo de errado com esse número. Digite novamente.", ES: "Hay algo mal en ese número. Vuelve a ingresarlo.", EN: "There is something wrong with this number. Enter it again.", Action: ActionFixData,
+		},
+		"E302": {
+			PT: "Confira o código de segurança.", ES: "Revisa el código de seguridad.", EN: "Check the security code.", Action: ActionFixData,
+		},
+		"316": {
+			PT: "Por favor, digite um nome válido.", ES: "Ingresa un nombre válido.", EN: "Enter a valid name.", Action: ActionFixData,
+		},
+		"322": {
+			PT: "Confira seu documento.", ES: "El tipo de documento es inválido.", EN: "Check your document type.", Action: ActionFixData,
+		},
+		"323": {
+			PT: "Confira seu documento.", ES: "Revisa tu documento.", EN: "Check your document.", Action: ActionFixData,
+		},
+		"324": {
+			PT: "Confira seu documento.", ES: "El documento es inválido.", EN: "Check your document.", Action: ActionFixData,
+		},
+		"325": {
+			PT: "Confira a data.", ES: "El mes es inválido.", EN: "Check the expiration month.", Action: ActionFixData,
+		},
+		"326": {
+			PT: "Confira a data.", ES: "El año es inválido.", EN: "Check the expiration year.", Action: ActionFixData,
+		},
+		"4050": {
+			PT: "Revise o seu e-mail.", ES: "Revisa tu e-mail.", EN: "Check your e-mail.", Action: ActionFixData,
+		},
+		"4051": {
+			PT: "O e-mail deve possuir no máximo 254 caracteres.", ES: "El e-mail debe tener como máximo 254 caracteres.", EN: "The e-mail must be at most 254 characters long.", Action: ActionFixData,
+		},
+		"3003": {
+			PT: "Não conseguimos processar os dados do cartão. Digite os dados novamente.", ES: "No pudimos procesar los datos de la tarjeta. Vuelve a ingresarlos.", EN: "We could not process the card details. Enter them again.", Action: ActionRetry,
+		},
+		"3029": {
+			PT: "Revise o mês de vencimento do cartão.", ES: "Revisa el mes de vencimiento de la tarjeta.", EN: "Check the card expiration month.", Action: ActionFixData,
+		},
+		"3030": {
+			PT: "Revise o ano de vencimento do cartão.", ES: "Revisa el año de vencimiento de la tarjeta.", EN: "Check the card expiration year.", Action: ActionFixData,
+		},
+		"4020": {
+			PT: "Erro de configuração da loja (notification_url inválida).", ES: "Error de configuración de la tienda (notification_url inválida).", EN: "Store configuration error (invalid notification_url).", Action: ActionContactSupport,
+		},
+		"4037": {
+			PT: "Erro de configuração da loja (valor da transação inválido).", ES: "Error de configuración de la tienda (monto de la transacción inválido).", EN: "Store configuration error (invalid transaction amount).", Action: ActionContactSupport,
+		},
+	}
+)
+
+// StatusDetailMessage é a função que retorna a mensagem para o pagador de um detalhe de status de pagamento (exemplo: cc_rejected_bad_filled_security_code).
+// Caso o detalhe de status não esteja no catálogo então retorna false.
+func StatusDetailMessage(statusDetail StatusDetail) (LocalizedMessage, bool) {
+	messagesMutex.RLock()
+	defer messagesMutex.RUnlock()
+	message, ok := statusDetailMessages[statusDetail]
+	return message, ok
+}
+
+// ErrorCauseMessage é a função que retorna a mensagem para o pagador de um código de causa de erro da API (exemplo: 2067).
+// Caso o código não esteja no catálogo então retorna false.
+func ErrorCauseMessage(code string) (LocalizedMessage, bool) {
+	messagesMutex.RLock()
+	defer messagesMutex.RUnlock()
+	message, ok := errorCauseMessages[code]
+	return message, ok
+}
+
+// RegisterStatusDetailMessage é a função que adiciona ou substitui a mensagem de um detalhe de status de pagamento no catálogo,
+// permitindo que a aplicação personalize as mensagens exibidas ao pagador.
+func RegisterStatusDetailMessage(statusDetail StatusDetail, message LocalizedMessage) {
+	messagesMutex.Lock()
+	defer messagesMutex.Unlock()
+	statusDetailMessages[statusDetail] = message
+}
+
+// RegisterErrorCauseMessage é a função que adiciona ou substitui a mensagem de um código de causa de erro da API no catálogo.
+func RegisterErrorCauseMessage(code string, message LocalizedMessage) {
+	messagesMutex.Lock()
+	defer messagesMutex.Unlock()
+	errorCauseMessages[code] = message
+}
+
+// PaymentMessage é a função que retorna a mensagem para o pagador sobre o status de um pagamento no idioma informado, com as variáveis
+// {payment_method} e {amount} substituídas pelos dados do pagamento, e a ação recomendada.
+// Caso o detalhe do status não esteja no catálogo então retorna uma mensagem genérica de acordo com o status do pagamento.
+func PaymentMessage(payment PaymentConsultResponse, language string) (string, RecommendedAction) {
+	message, ok := StatusDetailMessage(payment.StatusDetail)
+	if !ok {
+		message = genericStatusMessage(payment.Status)
+	}
+
+	paymentMethod := payment.PaymentMethodID
+	if paymentMethod == "" {
+		paymentMethod = LocalizedMessage{PT: "cartão", ES: "tarjeta", EN: "card"}.Text(language)
+	}
+
+	text := strings.NewReplacer(
+		"{payment_method}", paymentMethod,
+		"{amount}", payment.CurrencyID+" "+payment.TransactionAmount.RoundCurrency(payment.CurrencyID).String(),
+	).Replace(message.Text(language))
+
+	return strings.TrimSpace(text), message.Action
+}
+
+// ErrorMessage é a função que retorna a mensagem para o pagador da primeira causa conhecida de um erro retornado pelo MercadoPago no idioma informado.
+// Caso nenhuma causa do erro esteja no catálogo então retorna false.
+func ErrorMessage(errorResponse *ErrorResponse, language string) (string, RecommendedAction, bool) {
+	if errorResponse == nil {
+		return "", ActionNone, false
+	}
+	for _, cause := range errorResponse.Cause {
+		if message, ok := ErrorCauseMessage(cause.Code); ok {
+			return message.Text(language), message.Action, true
+		}
+	}
+	return "", ActionNone, false
+}
+
+// genericStatusMessage é a função que retorna uma mensagem genérica de acordo com o status do pagamento, usada quando o detalhe do status não é conhecido.
+func genericStatusMessage(status PaymentStatus) LocalizedMessage {
+	switch {
+	case status.IsApproved():
+		message, _ := StatusDetailMessage(StatusDetailAccredited)
+		return message
+	case status.IsPending():
+		return LocalizedMessage{PT: "Estamos processando o pagamento.", ES: "Estamos procesando tu pago.", EN: "We are processing your payment.", Action: ActionWait}
+	case status.IsRejected():
+		return LocalizedMessage{PT: "Não conseguimos processar seu pagamento. Escolha outra forma de pagamento.", ES: "No pudimos procesar tu pago. Elige otro medio de pago.", EN: "We could not process your payment. Choose another payment method.", Action: ActionChangeCard}
+	case status.IsCancelled():
+		return LocalizedMessage{PT: "O pagamento foi cancelado.", ES: "El pago fue cancelado.", EN: "The payment was cancelled.", Action: ActionNone}
+	case status.IsReversed():
+		message, _ := StatusDetailMessage(StatusDetailRefunded)
+		return message
+	}
+	return LocalizedMessage{PT: "Não foi possível obter a situação do pagamento.", ES: "No fue posible obtener el estado del pago.", EN: "The payment status could not be retrieved.", Action: ActionContactSupport}
+}
+
+// UnmarshalJSON é o método que decoda a causa do erro aceitando o código em formato de número ou de string.
+func (errorCause *ErrorCause) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Code        json.RawMessage `json:"code"`
+		Description string          `json:"description"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	errorCause.Code = unmarshalEnum(raw.Code)
+	errorCause.Description = raw.Description
+	return nil
+}
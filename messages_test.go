@@ -0,0 +1,91 @@
+package mercadopago
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// Testando as mensagens para o pagador dos detalhes de status de pagamento
+func TestPaymentMessage(t *testing.T) {
+
+	payment := PaymentConsultResponse{
+		Status:            StatusRejected,
+		StatusDetail:      StatusDetailCCRejectedCallForAuthorize,
+		PaymentMethodID:   "visa",
+		CurrencyID:        "BRL",
+		TransactionAmount: MustParseMoney("100.5"),
+	}
+
+	text, action := PaymentMessage(payment, "pt-BR")
+	if text != "Você deve autorizar ao visa o pagamento do valor de BRL 100.50." || action != ActionCallIssuer {
+		t.Error("Mensagem em português incorreta: " + text)
+	}
+
+	if text, _ := PaymentMessage(payment, "es_AR"); text != "Debes autorizar ante visa el pago de BRL 100.50." {
+		t.Error("Mensagem em espanhol incorreta: " + text)
+	}
+
+	if text, _ := PaymentMessage(payment, "de"); text != "Você deve autorizar ao visa o pagamento do valor de BRL 100.50." {
+		t.Error("Idiomas não suportados devem retornar a mensagem em português: " + text)
+	}
+
+	payment.StatusDetail = "cc_rejected_new_reason"
+	if _, action := PaymentMessage(payment, "en"); action != ActionChangeCard {
+		t.Error("Detalhes de status desconhecidos devem retornar a mensagem genérica do status!")
+	}
+
+	messagesMutex.RLock()
+	previous, exists := statusDetailMessages["cc_rejected_new_reason"]
+	messagesMutex.RUnlock()
+	t.Cleanup(func() {
+		messagesMutex.Lock()
+		defer messagesMutex.Unlock()
+		if exists {
+			statusDetailMessages["cc_rejected_new_reason"] = previous
+		} else {
+			delete(statusDetailMessages, "cc_rejected_new_reason")
+		}
+	})
+
+	RegisterStatusDetailMessage("cc_rejected_new_reason", LocalizedMessage{PT: "Nova mensagem.", Action: ActionRetry})
+	if text, action := PaymentMessage(payment, "en"); text != "Nova mensagem." || action != ActionRetry {
+		t.Error("Mensagem personalizada não aplicada: " + text)
+	}
+
+}
+
+// Testando se todos os detalhes de status conhecidos possuem mensagem no catálogo
+func TestStatusDetailMessagesCatalog(t *testing.T) {
+
+	for statusDetail := range knownStatusDetails {
+		message, ok := statusDetailMessages[statusDetail]
+		if !ok || message.PT == "" || message.ES == "" || message.EN == "" || message.Action == "" {
+			t.Error("Mensagem não cadastrada no catálogo para o detalhe de status " + string(statusDetail))
+		}
+	}
+
+}
+
+// Testando as mensagens para o pagador das causas dos erros da API
+func TestErrorMessage(t *testing.T) {
+
+	var errorResponse ErrorResponse
+	err := json.Unmarshal([]byte(`{"message": "Invalid user identification number", "error": "bad_request", "status": 400, "cause": [{"code": 9999, "description": "unknown"}, {"code": "2067", "description": "Invalid user identification number"}]}`), &errorResponse)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if errorResponse.Cause[0].Code != "9999" {
+		t.Error("Código numérico da causa do erro decodado incorretamente!")
+	}
+
+	text, action, ok := ErrorMessage(&errorResponse, "en")
+	if !ok || text != "Check the identification document number." || action != ActionFixData {
+		t.Error("Mensagem do erro incorreta: " + text)
+	}
+
+	if _, _, ok := ErrorMessage(nil, "pt"); ok {
+		t.Error("Não era esperado mensagem para um erro nulo!")
+	}
+
+}
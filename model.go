@@ -1,18 +1,18 @@
 package mercadopago
 
 import (
-	"time"
+	"encoding/json"
 
 	"github.com/eduardo-mior/mercadopago-sdk-go/internal/request"
 )
 
 // PaymentResponse é a struct que é usada para receber os dados do request de novo pagamento do MercadoPago.
 type PaymentResponse struct {
-	CollectorID   int      `json:"collector_id"`   // Nosso ID do MercadoPago
-	OperationType string   `json:"operation_type"` // Tipo da operação (regular_payment, money_transfer)
-	Items         []Item   `json:"items"`          // Itens vendidos
-	Payer         Payer    `json:"payer"`          // Informações do pagador da cobrança
-	BackUrls      BackUrls `json:"back_urls"`      // URLS de redirecionamento
+	CollectorID   int           `json:"collector_id"`   // Nosso ID do MercadoPago
+	OperationType OperationType `json:"operation_type"` // Tipo da operação (regular_payment, money_transfer)
+	Items         []Item        `json:"items"`          // Itens vendidos
+	Payer         Payer         `json:"payer"`          // Informações do pagador da cobrança
+	BackUrls      BackUrls      `json:"back_urls"`      // URLS de redirecionamento
 	// Indica se o comprador será redirecionado automaticamente para o back_urls após a compra
 	// Use "approved" para redirecionar apenas no caso de sucesso
 	// User "all" para todos os casos
@@ -20,20 +20,23 @@ type PaymentResponse struct {
 	PaymentMethods     PaymentMethods `json:"payment_methods"`      // Configurações das condições de pagamento do pagamento
 	ClientID           string         `json:"client_id"`            // ID do cliente do MercadoPago
 	Marketplace        string         `json:"marketplace"`          // Indica de qual marketplace foi feito pagamento (padrão NENHUM)
-	MarketplaceFee     float64        `json:"marketplace_fee"`      // Comissão de Mercado cobrada pelo proprietario do aplicativo
+	MarketplaceFee     Money          `json:"marketplace_fee"`      // Comissão de Mercado cobrada pelo proprietario do aplicativo
 	Shipments          Shipments      `json:"shipments"`            // Informações de envio dos itens
 	NotificationURL    string         `json:"notification_url"`     // URL do Webhook que é chamada quando o Status do pagamento é atualizado
 	ExternalReference  string         `json:"external_reference"`   // Nosso ID de controle interno
 	AdditionalInfo     string         `json:"additional_info"`      // Informações adicionais do pagamento
 	Expires            bool           `json:"expires"`              // Indica se o pagamento possui possui data de expiração
-	DateOfExpiration   *time.Time     `json:"date_of_expiration"`   // Data de expiração de meios de pagamento em dinheiro
-	ExpirationDateFrom *time.Time     `json:"expiration_date_from"` // A partir de qual data o pagamento estara ativo
-	ExpirationDateTo   *time.Time     `json:"expiration_date_to"`   // Até qual data o pagamento estara ativo
-	DateCreated        time.Time      `json:"date_created"`         // Data de criação do pagamento (gerado pelo MercadoPago)
+	DateOfExpiration   *Time          `json:"date_of_expiration"`   // Data de expiração de meios de pagamento em dinheiro
+	ExpirationDateFrom *Time          `json:"expiration_date_from"` // A partir de qual data o pagamento estara ativo
+	ExpirationDateTo   *Time          `json:"expiration_date_to"`   // Até qual data o pagamento estara ativo
+	DateCreated        Time           `json:"date_created"`         // Data de criação do pagamento (gerado pelo MercadoPago)
 	ID                 string         `json:"id"`                   // ID do pagamento do MercadoPago (gerado pelo MercadoPago)
 	InitPoint          string         `json:"init_point"`           // Link de pagamento do pagamento
 	SandboxInitPoint   string         `json:"sandbox_init_point"`   // Link de pagamento de staging do pagamento
 	SiteID             string         `json:"site_id"`              // ID do site do pagamento
+
+	// Metadados da resposta HTTP (status, headers, x-request-id) e JSON original retornado pelo MercadoPago
+	Response ResponseMetadata `json:"-"`
 }
 
 // PaymentRequest é a struct que é usada para fazer a request de um novo pagamento para o MercadoPago
@@ -46,19 +49,23 @@ type PaymentRequest struct {
 	// User "all" para todos os casos
 	AutoReturn          string               `json:"auto_return"`
 	BackUrls            BackUrls             `json:"back_urls"`                      // URLS de redirecionamento
-	DateOfExpiration    *time.Time           `json:"date_of_expiration"`             // Data de expiração de meios de pagamento em dinheiro
-	ExpirationDateFrom  *time.Time           `json:"expiration_date_from"`           // A partir de qual data o pagamento estara ativo
-	ExpirationDateTo    *time.Time           `json:"expiration_date_to"`             // Até qual data o pagamento estara ativo
+	DateOfExpiration    *Time                `json:"date_of_expiration"`             // Data de expiração de meios de pagamento em dinheiro
+	ExpirationDateFrom  *Time                `json:"expiration_date_from"`           // A partir de qual data o pagamento estara ativo
+	ExpirationDateTo    *Time                `json:"expiration_date_to"`             // Até qual data o pagamento estara ativo
 	Expires             bool                 `json:"expires"`                        // Indica se o pagamento possui possui data de expiração
 	DifferentialPricing *DifferentialPricing `json:"differential_pricing,omitempty"` // Configuração do preço diferenciado para este pagamento
 	Marketplace         string               `json:"marketplace"`                    // Indica de qual marketplace foi feito pagamento (padrão NENHUM)
-	MarketplaceFee      float64              `json:"marketplace_fee"`                // Comissão de Mercado cobrada pelo proprietario do aplicativo
+	MarketplaceFee      Money                `json:"marketplace_fee"`                // Comissão de Mercado cobrada pelo proprietario do aplicativo
 	NotificationURL     string               `json:"notification_url"`               // URL do Webhook que é chamada quando o Status do pagamento é atualizado
 	Payer               Payer                `json:"payer"`                          // Informações do pagador da cobrança
 	PaymentMethods      PaymentMethods       `json:"payment_methods"`                // Configurações das condições de pagamento do pagamento
 	StatementDescriptor string               `json:"statement_descriptor,omitempty"` // Descrição do pagamento que ira aparecer no extrato do cartão
 	Shipments           Shipments            `json:"shipments"`                      // Informações de envio dos itens
 	Tracks              []Track              `json:"tracks,omitempty"`               // Lista trackeamentos que serão executados durante a interação do fluxo de pagamento
+
+	// Site (país) usado na moeda padrão dos itens e nas validações deste pagamento, com prioridade sobre o site configurado (CurrentSite).
+	// Não é enviado para o MercadoPago, serve para um mesmo processo atender contas de países diferentes.
+	SiteID string `json:"-"`
 }
 
 // BackUrls é a struct que contém as URLs que são utilizadas para redicionar o usuário após a pagamentor ser realizado ou após acontecer algum erro
@@ -125,7 +132,7 @@ type Item struct {
 	CategoryID  string  `json:"category_id"`           // Identificador da categoria interno nosso de controle
 	Quantity    float64 `json:"quantity"`              // Quantidade do item vendido (obrigatório)
 	CurrencyID  string  `json:"currency_id"`           // Identificador universal da moeda que será usada no pagamento no formato ISO-4217
-	UnitPrice   float64 `json:"unit_price"`            // Preço unitário do item vendido (obrigatório)
+	UnitPrice   Money   `json:"unit_price"`            // Preço unitário do item vendido (obrigatório)
 }
 
 // Shipments é a struct que contém as informações de entrega/envio dos itens do pagamento
@@ -135,7 +142,7 @@ type Shipments struct {
 	Dimensions            string   `json:"dimensions"`              // Tamanho do pacote em cm x cm x cm (somente me2)
 	DefaultShippingMethod int      `json:"default_shipping_method"` // Método de envio padrão no _checkout_ (somente me2)
 	FreeMethos            []int    `json:"free_methods"`            // IDs dos métodos de envio com frete grátis (somente me2)
-	Cost                  float64  `json:"cost"`                    // Custo do frete (somente custom)
+	Cost                  Money    `json:"cost"`                    // Custo do frete (somente custom)
 	FreeShipping          bool     `json:"free_shipping"`           // Preferência por frete grátis (somente custom)
 	ReceiverAddress       *Address `json:"receiver_address"`        // Endereço de envio
 }
@@ -165,28 +172,31 @@ type PaymentSearchResponse struct {
 	NextOffset int                            `json:"next_offset"` // Número de ínicio da próxima busca
 	Total      int                            `json:"total"`       // Total de itens encontrados na busca
 	Elements   []PaymentSearchElementResponse `json:"elements"`    // Pagamentos retornados da busca
+
+	// Metadados da resposta HTTP (status, headers, x-request-id) e JSON original retornado pelo MercadoPago
+	Response ResponseMetadata `json:"-"`
 }
 
 // PaymentSearchElementResponse é a struct que contém toda as informações do pagamentos que são retornados no método de Search de pagamentos
 type PaymentSearchElementResponse struct {
-	ShippingMode       string     `json:"shipping_mode"`
-	ID                 string     `json:"id"`
-	CollectorID        int        `json:"collector_id"`
-	CorporationID      string     `json:"corporation_id"`
-	ExternalReference  string     `json:"external_reference"`
-	PayerID            *string    `json:"payer_id"`
-	PayerEmail         string     `json:"payer_email"`
-	ProcessingModes    []string   `json:"processing_modes"`
-	ProductID          string     `json:"product_id"`
-	DateCreated        time.Time  `json:"date_created"`
-	ExpirationDateFrom *time.Time `json:"expiration_date_from"`
-	ExpirationDateTo   *time.Time `json:"expiration_date_to"`
-	Marketplace        string     `json:"marketplace"`
-	ClientID           string     `json:"client_id"`
-	SiteID             string     `json:"site_id"`
-	Expires            bool       `json:"expires"`
-	Items              []string   `json:"items"`
-	OperationType      string     `json:"operation_type"`
+	ShippingMode       string        `json:"shipping_mode"`
+	ID                 string        `json:"id"`
+	CollectorID        int           `json:"collector_id"`
+	CorporationID      string        `json:"corporation_id"`
+	ExternalReference  string        `json:"external_reference"`
+	PayerID            *string       `json:"payer_id"`
+	PayerEmail         string        `json:"payer_email"`
+	ProcessingModes    []string      `json:"processing_modes"`
+	ProductID          string        `json:"product_id"`
+	DateCreated        Time          `json:"date_created"`
+	ExpirationDateFrom *Time         `json:"expiration_date_from"`
+	ExpirationDateTo   *Time         `json:"expiration_date_to"`
+	Marketplace        string        `json:"marketplace"`
+	ClientID           string        `json:"client_id"`
+	SiteID             string        `json:"site_id"`
+	Expires            bool          `json:"expires"`
+	Items              []string      `json:"items"`
+	OperationType      OperationType `json:"operation_type"`
 }
 
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -206,15 +216,15 @@ type IdentificationType struct {
 type PaymentMethod struct {
 	ID                    string                               `json:"id"`                     // ID único do método de pagamento
 	Name                  string                               `json:"name"`                   // Nome do método de pagamento
-	PaymentTypeID         string                               `json:"payment_type_id"`        // Tipo do meio de pagamento (ticket, atm, credit_card, debit_card, prepaid_card)
+	PaymentTypeID         PaymentTypeID                        `json:"payment_type_id"`        // Tipo do meio de pagamento (ticket, atm, credit_card, debit_card, prepaid_card)
 	Status                string                               `json:"status"`                 // Status do meio de pagamento (active, deactive, temporally_deactive)
 	SecureThumbnail       string                               `json:"secure_thumbnail"`       // Logo do método de pagamento que deve ser mostrada em sites seguros
 	Thumbnail             string                               `json:"thumbnail"`              // Logo do método de pagamento
 	DeferredCapture       string                               `json:"deferred_capture"`       // Indica se a captura pode ser lenta ou não
 	Settings              []PaymentMethodSettings              `json:"settings"`               // Configurações do método de pagamento
 	AdditionalInfoNeeded  []string                             `json:"additional_info_needed"` // Lista de informações que devem ser fornecidas pelo pagador
-	MinAllowedAmount      float64                              `json:"min_allowed_amount"`     // Mínimo valor que pode ser processado com este meio de pagamento
-	MaxAllowedAmount      int                                  `json:"max_allowed_amount"`     // Máxilo valor que pode ser processado com este meio de pagamento
+	MinAllowedAmount      Money                                `json:"min_allowed_amount"`     // Mínimo valor que pode ser processado com este meio de pagamento
+	MaxAllowedAmount      Money                                `json:"max_allowed_amount"`     // Máxilo valor que pode ser processado com este meio de pagamento
 	AccreditationTime     int                                  `json:"accreditation_time"`     // Tempo de processamento do pagamento
 	FinancialInstitutions []PaymentMethodFinancialInstitutions `json:"financial_institutions"` // Instituições financeiras de processamento do meio de pagamento
 	ProcessingModes       []string                             `json:"processing_modes"`       // Modos de processamento
@@ -252,6 +262,50 @@ type PaymentMethodFinancialInstitutions struct {
 	Description string `json:"description"` // Nome descritivo da instituição financeira
 }
 
+// InstallmentsParams é a struct que contém os filtros usados na consulta de parcelamentos.
+// Deve ser informado o Bin do cartão ou o PaymentMethodID, o IssuerID é opcional.
+type InstallmentsParams struct {
+	Amount          Money  // Valor que sera parcelado (obrigatório)
+	Bin             string // Seis (ou oito) primeiros digitos do cartão de crédito
+	PaymentMethodID string // ID do método de pagamento (exemplo: master)
+	IssuerID        string // ID do banco emissor do cartão
+}
+
+// Installments é a struct que contém as opções de parcelamento de um método de pagamento para um determinado valor
+type Installments struct {
+	PaymentMethodID   string        `json:"payment_method_id"`   // ID do método de pagamento (exemplo: master)
+	PaymentTypeID     PaymentTypeID `json:"payment_type_id"`     // Tipo do método de pagamento (exemplo: credit_card)
+	Thumbnail         string        `json:"thumbnail"`           // Logo do método de pagamento
+	SecureThumbnail   string        `json:"secure_thumbnail"`    // Logo do método de pagamento que deve ser mostrada em sites seguros
+	ProcessingMode    string        `json:"processing_mode"`     // Modo de processamento (aggregator, gateway)
+	MerchantAccountID *string       `json:"merchant_account_id"` // ID da conta do vendedor (somente no modo gateway)
+	Issuer            Issuer        `json:"issuer"`              // Banco emissor do cartão
+	PayerCosts        []PayerCost   `json:"payer_costs"`         // Opções de parcelamento
+}
+
+// Issuer é a struct que contém as informações do banco emissor de um cartão
+type Issuer struct {
+	ID              string `json:"id"`               // ID único do banco emissor
+	Name            string `json:"name"`             // Nome do banco emissor
+	Thumbnail       string `json:"thumbnail"`        // Logo do banco emissor
+	SecureThumbnail string `json:"secure_thumbnail"` // Logo do banco emissor que deve ser mostrada em sites seguros
+	ProcessingMode  string `json:"processing_mode"`  // Modo de processamento (aggregator, gateway)
+	Status          string `json:"status"`           // Status do banco emissor (active, deactive)
+}
+
+// PayerCost é a struct que contém as informações de uma opção de parcelamento
+type PayerCost struct {
+	Installments       int      `json:"installments"`        // Número de parcelas
+	InstallmentRate    float64  `json:"installment_rate"`    // Taxa de juros (em porcentagem) aplicada sobre o valor total
+	DiscountRate       float64  `json:"discount_rate"`       // Taxa de desconto (em porcentagem)
+	Labels             []string `json:"labels"`              // Etiquetas da opção de parcelamento (exemplo: CFT_0,00%|TEA_0,00%, recommended_installment)
+	MinAllowedAmount   Money    `json:"min_allowed_amount"`  // Mínimo valor permitido para esta opção de parcelamento
+	MaxAllowedAmount   Money    `json:"max_allowed_amount"`  // Máximo valor permitido para esta opção de parcelamento
+	RecommendedMessage string   `json:"recommended_message"` // Mensagem recomendada para exibir ao pagador (exemplo: 3 parcelas de R$ 33,33 (R$ 100,00))
+	InstallmentAmount  Money    `json:"installment_amount"`  // Valor de cada parcela
+	TotalAmount        Money    `json:"total_amount"`        // Valor total que sera pago com os juros
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // WebhookResponse é a struct que é usada para receber os dados do request que o MercadoPago faz para o nosso webhook.
@@ -259,7 +313,7 @@ type WebhookResponse struct {
 	ID          int              `json:"id"`           // ID único da resposta do Webhook
 	LiveMode    bool             `json:"live_mode"`    // ???
 	Type        string           `json:"type"`         // Tipo do evento, exemplo: payment
-	DateCreated string           `json:"date_created"` // Data de criação do Webhook
+	DateCreated Time             `json:"date_created"` // Data de criação do Webhook
 	UserID      string           `json:"user_id"`      // Nosso ID do MercadoPago
 	APIVersion  string           `json:"api_version"`  // Versão da API, exemplo: v1
 	Action      string           `json:"action"`       // Ação do evento, exemplo: payment.created
@@ -274,34 +328,46 @@ type WebhookPaymentID struct {
 // PaymentConsultResponse é a struct que contém as informações do retorno da Consulta de um pagamento.
 type PaymentConsultResponse struct {
 	AdditionalInfo            PaymentConsultAdditionalInfo `json:"additional_info"`                // Informações adicionais do pagamento
+	AcquirerReconciliation    []map[string]interface{}     `json:"acquirer_reconciliation"`        // Informações de conciliação com o adquirente
 	AuthorizationCode         *string                      `json:"authorization_code"`             // Código de autorização do pagamento
 	BinaryMode                bool                         `json:"binary_mode"`                    // Indica se é o modo binaria de pagamento ou não
 	Captured                  bool                         `json:"captured"`                       // Indica se o pagamento foi capturado ou não ???
 	Card                      PaymentConsultCard           `json:"card"`                           // Informações do cartão de crédito do pagamento
+	ChargesDetails            []ChargeDetail               `json:"charges_details"`                // Detalhes das cobranças (taxas, financiamentos) aplicadas sobre o pagamento
 	CollectorID               int                          `json:"collector_id"`                   // Nosso ID do MercadoPago
+	CouponAmount              Money                        `json:"coupon_amount"`                  // Valor do desconto do cupom aplicado ao pagamento
 	CurrencyID                string                       `json:"currency_id"`                    // Identificador universal da moeda que será usada no pagamento no formato ISO-4217
-	DateApproved              *time.Time                   `json:"date_approved"`                  // Data da aprovação do pagamento
-	DateCreated               time.Time                    `json:"date_created"`                   // Data da criação do pagamento
-	DateLastUpdated           *time.Time                   `json:"date_last_updated"`              // Data da ultima atualização do pagamento
-	DateOfExpiration          *time.Time                   `json:"date_of_expiration"`             // Data de expiração de meios de pagamento em dinheiro
+	DateApproved              *Time                        `json:"date_approved"`                  // Data da aprovação do pagamento
+	DateCreated               Time                         `json:"date_created"`                   // Data da criação do pagamento
+	DateLastUpdated           *Time                        `json:"date_last_updated"`              // Data da ultima atualização do pagamento
+	DateOfExpiration          *Time                        `json:"date_of_expiration"`             // Data de expiração de meios de pagamento em dinheiro
 	Description               string                       `json:"description"`                    // Descrição do pagamento
-	DifferentialPricingId     string                       `json:"differential_pricing_id"`        // Identificador único da configuração de preço diferenciado
+	DifferentialPricingId     FlexibleString               `json:"differential_pricing_id"`        // Identificador único da configuração de preço diferenciado
 	ExternalReference         string                       `json:"external_reference"`             // Nosso ID de controle interno
 	FeeDetails                []FeeDetails                 `json:"fee_details"`                    // Informações sobre as taxas que foram aplicadas sobre o pagamento
 	ID                        int                          `json:"id"`                             // Identificador único do pagamento
 	Installments              int                          `json:"installments"`                   // Número máximo de parcelas
+	IssuerID                  FlexibleString               `json:"issuer_id"`                      // ID do banco emissor do método de pagamento
 	LiveMode                  bool                         `json:"live_mode"`                      // ???
-	MoneyReleaseDate          *time.Time                   `json:"money_release_date"`             // Data da liberação do dinheiro na nossa conta do MercadoPago
+	Metadata                  map[string]interface{}       `json:"metadata"`                       // Metadados enviados na criação do pagamento
+	MoneyReleaseDate          *Time                        `json:"money_release_date"`             // Data da liberação do dinheiro na nossa conta do MercadoPago
 	MoneyReleaseSchema        *string                      `json:"money_release_schema"`           // Esquema da liberação do dinheiro
+	MoneyReleaseStatus        *string                      `json:"money_release_status"`           // Situação da liberação do dinheiro (exemplo: released, pending)
 	NotificationURL           string                       `json:"notification_url"`               // URL do Webhook que é chamada quando o Status do pagamento é atualizado
-	OperationType             string                       `json:"operation_type"`                 // Tipo do pagamento (consulte a documentação para saber oque significa)
+	OperationType             OperationType                `json:"operation_type"`                 // Tipo do pagamento (consulte a documentação para saber oque significa)
+	Order                     *PaymentOrder                `json:"order"`                          // Ordem (merchant order) à qual o pagamento esta vinculado
 	Payer                     Payer                        `json:"payer"`                          // Informações do pagador
+	PaymentMethod             *PaymentConsultPaymentMethod `json:"payment_method"`                 // Informações do método de pagamento
 	PaymentMethodID           string                       `json:"payment_method_id"`              // ID do método de pagamento (exemplo: master)
-	PaymentTypeID             string                       `json:"payment_type_id"`                // Tipo do método de pagamento (exemplo: credit_card)
+	PaymentTypeID             PaymentTypeID                `json:"payment_type_id"`                // Tipo do método de pagamento (exemplo: credit_card)
 	ProcessingModes           string                       `json:"processing_modes"`               // Modo de processamento
+	Refunds                   []Refund                     `json:"refunds"`                        // Reembolsos realizados no pagamento
+	ShippingAmount            Money                        `json:"shipping_amount"`                // Valor do frete
 	StatementDescriptor       string                       `json:"statement_descriptor,omitempty"` // Descrição do pagamento que ira aparecer no extrato do cartão
-	TransactionAmount         float64                      `json:"transaction_amount"`             // Valor pago
-	TransactionAmountRefunded float64                      `json:"transaction_amount_refunded"`    // Valor do reembolso
+	TaxesAmount               Money                        `json:"taxes_amount"`                   // Valor dos impostos
+	ThreeDSInfo               *ThreeDSInfo                 `json:"three_ds_info"`                  // Informações da autenticação 3DS do cartão
+	TransactionAmount         Money                        `json:"transaction_amount"`             // Valor pago
+	TransactionAmountRefunded Money                        `json:"transaction_amount_refunded"`    // Valor do reembolso
 	TransactionDetails        TransactionDetails           `json:"transaction_details"`            // Detalhes da transação
 
 	// Código de barras do boleto
@@ -323,7 +389,7 @@ type PaymentConsultResponse struct {
 	// cancelled - O pagamento foi cancelado por uma das partes ou porque ele expirou
 	// refunded - O pagamento foi reembolsado para o usuário
 	// charged_back - Foi feito um estorno do pagamento no cartão de crédito do usuário
-	Status string `json:"status"`
+	Status PaymentStatus `json:"status"`
 
 	// Detalhes sobre o status do pagamento. A lista completa pode ser consultada em https://www.mercadopago.com.br/developers/pt/guides/online-payments/checkout-api/handling-responses
 	// cc_rejected_card_disabled (cartão de crédito bloqueado)
@@ -335,14 +401,20 @@ type PaymentConsultResponse struct {
 	// pending_waiting_payment (aguardando pagamento do boleto ou do PEC(lotérica))
 	// accredited (aprovado)
 	// https://prnt.sc/1ta9w4b
-	StatusDetail string `json:"status_detail"`
+	StatusDetail StatusDetail `json:"status_detail"`
+
+	// JSON original retornado pelo MercadoPago, útil para ler campos que ainda não foram mapeados na struct
+	RawJSON json.RawMessage `json:"-"`
+
+	// Metadados da resposta HTTP (status, headers, x-request-id) do ConsultPayment
+	Response ResponseMetadata `json:"-"`
 }
 
 // PaymentConsultCard é a struct que contém as informações do cartão de crédito que efetuou o pagamento
 type PaymentConsultCard struct {
 	Cardholder      Cardholder `json:"cardholder"`        // Informações do dono do cartão
-	DateCreated     *time.Time `json:"date_created"`      // Data de criação do cartão
-	DateLastUpdated *time.Time `json:"date_last_updated"` // Data da ultima atualização do cartão
+	DateCreated     *Time      `json:"date_created"`      // Data de criação do cartão
+	DateLastUpdated *Time      `json:"date_last_updated"` // Data da ultima atualização do cartão
 	ExpirationMonth int        `json:"expiration_month"`  // Mês de expiração do cartão
 	ExpirationYear  int        `json:"expiration_year"`   // Ano de expiração do cartão
 	FirstSixDigits  string     `json:"first_six_digits"`  // Seis primeiros digitos do cartão
@@ -357,28 +429,138 @@ type Cardholder struct {
 
 // PaymentConsultAdditionalInfo é a struct que contém informações adicionais sobre o pagamento
 type PaymentConsultAdditionalInfo struct {
-	IPAddress string `json:"ip_address"` // IP do usuário que pagou
+	IPAddress string                         `json:"ip_address"` // IP do usuário que pagou
+	Items     []PaymentConsultItem           `json:"items"`      // Itens que foram cobrados no pagamento
+	Payer     *PaymentConsultAdditionalPayer `json:"payer"`      // Informações do pagador enviadas na criação do pagamento
+	Shipments *PaymentConsultShipments       `json:"shipments"`  // Informações de entrega dos itens do pagamento
+}
+
+// PaymentConsultItem é a struct que contém as informações de um item cobrado no pagamento.
+// O MercadoPago retorna a quantidade e o preço unitário dos itens como string ("1", "100.5"), por isso são usados os tipos FlexibleNumber e Money.
+type PaymentConsultItem struct {
+	ID          string         `json:"id"`          // Identificador interno nosso de controle
+	Title       string         `json:"title"`       // Titulo do item
+	Description string         `json:"description"` // Descrição do item
+	PictureURL  string         `json:"picture_url"` // Imagem do item
+	CategoryID  string         `json:"category_id"` // Identificador da categoria interno nosso de controle
+	Quantity    FlexibleNumber `json:"quantity"`    // Quantidade do item vendido
+	UnitPrice   Money          `json:"unit_price"`  // Preço unitário do item vendido
+}
+
+// PaymentConsultAdditionalPayer é a struct que contém as informações do pagador que foram enviadas nas informações adicionais do pagamento
+type PaymentConsultAdditionalPayer struct {
+	FirstName        string                 `json:"first_name"`        // Nome do pagador
+	LastName         string                 `json:"last_name"`         // Sobrenome do pagador
+	Phone            *PaymentConsultPhone   `json:"phone"`             // Telefone do pagador
+	Address          *PaymentConsultAddress `json:"address"`           // Endereço do pagador
+	RegistrationDate *Time                  `json:"registration_date"` // Data de cadastro do pagador no nosso sistema
+}
+
+// PaymentConsultPhone é a struct que contém as informações do telefone do pagador retornadas na consulta do pagamento
+type PaymentConsultPhone struct {
+	AreaCode FlexibleString `json:"area_code"` // Código de área do telefone
+	Number   FlexibleString `json:"number"`    // Número do telefone
+}
+
+// PaymentConsultAddress é a struct que contém as informações de um endereço retornadas na consulta do pagamento
+type PaymentConsultAddress struct {
+	ZipCode      FlexibleString `json:"zip_code"`      // CEP do endereço
+	StreetName   string         `json:"street_name"`   // Nome da rua do endereço
+	StreetNumber FlexibleString `json:"street_number"` // Número do endereço
+	CityName     string         `json:"city_name"`     // Nome da cidade do endereço
+	StateName    string         `json:"state_name"`    // Nome do estado do endereço
+	Floor        FlexibleString `json:"floor"`         // Número do andar
+	Apartment    FlexibleString `json:"apartment"`     // Número do apartamento
+}
+
+// PaymentConsultShipments é a struct que contém as informações de entrega dos itens retornadas na consulta do pagamento
+type PaymentConsultShipments struct {
+	ReceiverAddress *PaymentConsultAddress `json:"receiver_address"` // Endereço de entrega
+}
+
+// PaymentOrder é a struct que contém as informações da ordem (merchant order) à qual o pagamento esta vinculado
+type PaymentOrder struct {
+	ID   FlexibleString `json:"id"`   // Identificador da ordem
+	Type string         `json:"type"` // Tipo da ordem (exemplo: mercadopago, mercadolibre)
+}
+
+// PaymentConsultPaymentMethod é a struct que contém as informações do método de pagamento retornadas na consulta do pagamento
+type PaymentConsultPaymentMethod struct {
+	ID       string                 `json:"id"`        // ID do método de pagamento (exemplo: master)
+	Type     PaymentTypeID          `json:"type"`      // Tipo do método de pagamento (exemplo: credit_card)
+	IssuerID FlexibleString         `json:"issuer_id"` // ID do banco emissor do método de pagamento
+	Data     map[string]interface{} `json:"data"`      // Dados adicionais do método de pagamento
+}
+
+// Refund é a struct que contém as informações de um reembolso realizado no pagamento
+type Refund struct {
+	ID                   int64                  `json:"id"`                     // Identificador único do reembolso
+	PaymentID            int64                  `json:"payment_id"`             // Identificador do pagamento reembolsado
+	Amount               Money                  `json:"amount"`                 // Valor reembolsado
+	AdjustmentAmount     Money                  `json:"adjustment_amount"`      // Valor de ajuste do reembolso
+	Metadata             map[string]interface{} `json:"metadata"`               // Metadados enviados na criação do reembolso
+	Source               RefundSource           `json:"source"`                 // Quem solicitou o reembolso
+	DateCreated          Time                   `json:"date_created"`           // Data da criação do reembolso
+	UniqueSequenceNumber *string                `json:"unique_sequence_number"` // Número de sequência único do reembolso
+	RefundMode           string                 `json:"refund_mode"`            // Modo do reembolso (exemplo: standard)
+	Reason               *string                `json:"reason"`                 // Motivo do reembolso
+	Status               string                 `json:"status"`                 // Status do reembolso (exemplo: approved)
+}
+
+// RefundSource é a struct que contém as informações de quem solicitou o reembolso
+type RefundSource struct {
+	ID   FlexibleString `json:"id"`   // Identificador de quem solicitou o reembolso
+	Name string         `json:"name"` // Nome de quem solicitou o reembolso
+	Type string         `json:"type"` // Tipo de quem solicitou o reembolso (exemplo: collector, admin)
+}
+
+// ThreeDSInfo é a struct que contém as informações necessárias para a autenticação 3DS do cartão
+type ThreeDSInfo struct {
+	ExternalResourceURL string `json:"external_resource_url"` // URL do desafio 3DS
+	Creq                string `json:"creq"`                  // Identificador da requisição do desafio 3DS
+}
+
+// ChargeDetail é a struct que contém as informações de uma cobrança aplicada sobre o pagamento
+type ChargeDetail struct {
+	ID          string                 `json:"id"`           // Identificador da cobrança
+	Name        string                 `json:"name"`         // Nome da cobrança (exemplo: mercadopago_fee)
+	Type        string                 `json:"type"`         // Tipo da cobrança (exemplo: fee, financing)
+	Accounts    ChargeAccounts         `json:"accounts"`     // Contas de origem e destino da cobrança
+	ClientID    int64                  `json:"client_id"`    // Identificador do cliente
+	DateCreated Time                   `json:"date_created"` // Data da criação da cobrança
+	LastUpdated Time                   `json:"last_updated"` // Data da ultima atualização da cobrança
+	Amounts     ChargeAmounts          `json:"amounts"`      // Valores da cobrança
+	Metadata    map[string]interface{} `json:"metadata"`     // Metadados da cobrança
+	ReserveID   *string                `json:"reserve_id"`   // Identificador da reserva
+}
+
+// ChargeAccounts é a struct que contém as contas de origem e de destino de uma cobrança
+type ChargeAccounts struct {
+	From string `json:"from"` // Conta de origem (exemplo: collector)
+	To   string `json:"to"`   // Conta de destino (exemplo: mp)
+}
 
-	// Items     []Item `json:"items"`
-	// Por algum motivo eles retoram a Quantity do item e o UnityPrice como String e isso faz com que aconteça erro no Unmarshal do JSON.
-	// Por esse motivo foi comentado o campo Items das informações adicionais.
+// ChargeAmounts é a struct que contém os valores de uma cobrança
+type ChargeAmounts struct {
+	Original Money `json:"original"` // Valor original da cobrança
+	Refunded Money `json:"refunded"` // Valor reembolsado da cobrança
 }
 
 // FeeDetails é a struct que contém as informações sobre a taxa que foi cobrada sobre o pagamento
 type FeeDetails struct {
-	Amount   float64 `json:"amount"`    // Porcentagem da taxa que foi paga
-	FeePayer string  `json:"fee_payer"` // Indica que ira pagar a taxa
-	Type     string  `json:"type"`      // Indica quem esta cobrando a taxa
+	Amount   Money  `json:"amount"`    // Valor da taxa que foi paga
+	FeePayer string `json:"fee_payer"` // Indica que ira pagar a taxa
+	Type     string `json:"type"`      // Indica quem esta cobrando a taxa
 }
 
 // TransactionDetails é a struct que contém as informações sobre os detalhes da transação
 type TransactionDetails struct {
 	ExternalResourceURL      *string `json:"external_resource_url"`       // URL do Boleto ou do PEC caso a forma de pagamento for boleto ou lotéricas
 	FinancialInstitution     *string `json:"financial_institution"`       // Instituição financeira responsavel pelo pagamento
-	TotalPaidAmount          float64 `json:"total_paid_amount"`           // Valor total pago
-	InstallmentAmount        float64 `json:"installment_amount"`          // Valor do pagamento / Valor da parcela
-	NetReceivedAmount        float64 `json:"net_received_amount"`         // Valor liquido recebido com o valor descontado das taxas
-	OverpaidAmount           float64 `json:"overpaid_amount"`             // Valor pago em excesso ???
+	TotalPaidAmount          Money   `json:"total_paid_amount"`           // Valor total pago
+	InstallmentAmount        Money   `json:"installment_amount"`          // Valor do pagamento / Valor da parcela
+	NetReceivedAmount        Money   `json:"net_received_amount"`         // Valor liquido recebido com o valor descontado das taxas
+	OverpaidAmount           Money   `json:"overpaid_amount"`             // Valor pago em excesso ???
 	PayableDeferralPeriod    string  `json:"payable_deferral_period"`     // ????
 	PaymentMethodReferenceID string  `json:"payment_method_reference_id"` // ID de referencia do método de pagamento
 	TransactionID            *string `json:"transaction_id"`              // ID da transação
@@ -393,20 +575,327 @@ type Barcode struct {
 // No caso de ser PIX possui a chave do PIX no TransactionData.
 // No caso de ser cartão de crédito ou outras formas de pagamento possui algumas outras informações irrelevantes.
 type PointOfInteraction struct {
+	Type            string           `json:"type"`             // Tipo do ponto de interação (exemplo: PIX, CHECKOUT)
+	SubType         *string          `json:"sub_type"`         // Subtipo do ponto de interação
+	ApplicationData *ApplicationData `json:"application_data"` // Informações da aplicação que criou o pagamento
 	TransactionData *TransactionData `json:"transaction_data"` // Informações do QRCode
 }
 
+// ApplicationData é a struct que contém as informações da aplicação que criou o pagamento
+type ApplicationData struct {
+	Name    *string `json:"name"`    // Nome da aplicação
+	Version *string `json:"version"` // Versão da aplicação
+}
+
 // TransactionData é a struct que contém as informações do Base64 do QRCode e a chave Pix Copia-e-Cola
 type TransactionData struct {
-	QrCode       string `json:"qr_code"`        // Chave Pix Copia-e-Cola
-	QrCodeBase64 string `json:"qr_code_base64"` // Base64 Do QRCode do Pix
+	QrCode        string    `json:"qr_code"`        // Chave Pix Copia-e-Cola
+	QrCodeBase64  string    `json:"qr_code_base64"` // Base64 Do QRCode do Pix
+	TicketURL     string    `json:"ticket_url"`     // URL da página de pagamento do Pix (ou do comprovante)
+	TransactionID *string   `json:"transaction_id"` // Identificador da transação Pix
+	E2EID         *string   `json:"e2e_id"`         // Identificador fim a fim (end-to-end) da transação Pix
+	BankInfo      *BankInfo `json:"bank_info"`      // Informações das contas bancárias envolvidas no Pix
+}
+
+// BankInfo é a struct que contém as informações das contas bancárias do pagador e do recebedor de um Pix
+type BankInfo struct {
+	Payer                  BankInfoAccount `json:"payer"`                      // Conta bancária do pagador
+	Collector              BankInfoAccount `json:"collector"`                  // Conta bancária do recebedor
+	IsSameBankAccountOwner FlexibleString  `json:"is_same_bank_account_owner"` // Indica se o pagador e o recebedor são o mesmo titular
+}
+
+// BankInfoAccount é a struct que contém as informações de uma conta bancária envolvida em um Pix
+type BankInfoAccount struct {
+	AccountID         FlexibleString `json:"account_id"`          // Número da conta
+	ID                FlexibleString `json:"id"`                  // Identificador da conta
+	LongName          *string        `json:"long_name"`           // Nome do banco
+	AccountHolderName *string        `json:"account_holder_name"` // Nome do titular da conta
+	TransferAccountID FlexibleString `json:"transfer_account_id"` // Identificador da conta de transferência
+}
+
+///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// PixPaymentRequest é a struct que contém as informações usadas para criar uma cobrança Pix no MercadoPago.
+type PixPaymentRequest struct {
+	TransactionAmount Money                  `json:"transaction_amount"`           // Valor da cobrança (obrigatório)
+	Description       string                 `json:"description,omitempty"`        // Descrição da cobrança
+	Payer             PaymentPayer           `json:"payer"`                        // Informações do pagador (o email é obrigatório)
+	DateOfExpiration  *Time                  `json:"date_of_expiration,omitempty"` // Data de expiração do Pix (padrão do MercadoPago: 24 horas)
+	ExternalReference string                 `json:"external_reference,omitempty"` // Nosso ID de controle interno
+	NotificationURL   string                 `json:"notification_url,omitempty"`   // URL do Webhook que é chamada quando o Status do pagamento é atualizado
+	Metadata          map[string]interface{} `json:"metadata,omitempty"`           // Metadados que serão retornados na consulta do pagamento
+
+	// Chave de idempotência enviada no header X-Idempotency-Key. Repetir a chave em uma nova tentativa evita criar cobranças duplicadas.
+	// Caso não seja informada então uma chave aleatória é gerada.
+	IdempotencyKey string `json:"-"`
+
+	// Site (país) usado nas validações desta cobrança, com prioridade sobre o site configurado (CurrentSite). Não é enviado para o MercadoPago.
+	SiteID string `json:"-"`
+}
+
+// PaymentPayer é a struct que contém as informações do pagador usadas na criação de um pagamento pela API de pagamentos (/v1/payments).
+type PaymentPayer struct {
+	Email          string               `json:"email"`                // Email do pagador
+	FirstName      string               `json:"first_name,omitempty"` // Nome do pagador
+	LastName       string               `json:"last_name,omitempty"`  // Sobrenome do pagador
+	Identification PayerIdentification  `json:"identification"`       // Documento de identificação do pagador (exemplo: CPF)
+	Address        *PaymentPayerAddress `json:"address,omitempty"`    // Endereço do pagador (obrigatório no boleto)
+}
+
+// PaymentPayerAddress é a struct que contém o endereço do pagador usado na criação de um pagamento pela API de pagamentos (/v1/payments).
+type PaymentPayerAddress struct {
+	ZipCode      string `json:"zip_code"`      // CEP do endereço do pagador
+	StreetName   string `json:"street_name"`   // Nome da rua do endereço do pagador
+	StreetNumber string `json:"street_number"` // Número do endereço do pagador
+	Neighborhood string `json:"neighborhood"`  // Bairro do endereço do pagador
+	City         string `json:"city"`          // Cidade do endereço do pagador
+	FederalUnit  string `json:"federal_unit"`  // Sigla do estado do endereço do pagador (exemplo: SP)
+}
+
+// BoletoPaymentRequest é a struct que contém as informações usadas para criar um boleto ou um pagamento em lotérica (PEC) no MercadoPago.
+type BoletoPaymentRequest struct {
+	TransactionAmount Money                  `json:"transaction_amount"`           // Valor do boleto (obrigatório)
+	PaymentMethodID   string                 `json:"payment_method_id"`            // Método de pagamento: bolbradesco (boleto) ou pec (lotérica), o padrão é bolbradesco
+	Description       string                 `json:"description,omitempty"`        // Descrição do boleto
+	Payer             PaymentPayer           `json:"payer"`                        // Informações do pagador (nome, sobrenome, email, CPF/CNPJ e endereço são obrigatórios no boleto)
+	DateOfExpiration  *Time                  `json:"date_of_expiration,omitempty"` // Data de vencimento do boleto (padrão do MercadoPago: 3 dias úteis)
+	ExternalReference string                 `json:"external_reference,omitempty"` // Nosso ID de controle interno
+	NotificationURL   string                 `json:"notification_url,omitempty"`   // URL do Webhook que é chamada quando o Status do pagamento é atualizado
+	Metadata          map[string]interface{} `json:"metadata,omitempty"`           // Metadados que serão retornados na consulta do pagamento
+
+	// Chave de idempotência enviada no header X-Idempotency-Key. Repetir a chave em uma nova tentativa evita criar boletos duplicados.
+	// Caso não seja informada então uma chave aleatória é gerada.
+	IdempotencyKey string `json:"-"`
+
+	// Site (país) usado nas validações desta cobrança, com prioridade sobre o site configurado (CurrentSite). Não é enviado para o MercadoPago.
+	SiteID string `json:"-"`
+}
+
+// BoletoCharge é a struct que contém as informações de um boleto ou de um pagamento em lotérica (PEC) criado no MercadoPago.
+type BoletoCharge struct {
+	ID               int                    // Identificador único do pagamento
+	Status           PaymentStatus          // Status do pagamento (pending até o boleto ser pago)
+	StatusDetail     StatusDetail           // Detalhe do status do pagamento
+	PaymentMethodID  string                 // Método de pagamento (bolbradesco ou pec)
+	Barcode          string                 // Código de barras do boleto (44 digitos)
+	DigitableLine    string                 // Linha digitável do boleto (47 digitos, sem pontuação)
+	TicketURL        string                 // URL do boleto para impressão (ou do comprovante da lotérica)
+	DateOfExpiration *Time                  // Data de vencimento do boleto
+	Payment          PaymentConsultResponse // Pagamento completo retornado pelo MercadoPago
+}
+
+// PixCharge é a struct que contém as informações de uma cobrança Pix criada no MercadoPago.
+type PixCharge struct {
+	ID               int                    // Identificador único do pagamento
+	Status           PaymentStatus          // Status do pagamento (pending até o Pix ser pago)
+	StatusDetail     StatusDetail           // Detalhe do status do pagamento
+	QrCode           string                 // Chave Pix Copia-e-Cola (código EMV do BR Code)
+	QrCodePNG        []byte                 // Imagem PNG do QRCode gerada pelo MercadoPago
+	TicketURL        string                 // URL da página de pagamento do Pix
+	DateOfExpiration *Time                  // Data de expiração do Pix
+	Payment          PaymentConsultResponse // Pagamento completo retornado pelo MercadoPago
+}
+
+///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// PaymentConsultSearchParams é um map[string]interface{} que contém os filtros usados na busca de pagamentos da API de pagamentos (/v1/payments/search).
+// Exemplos de filtros: external_reference, status, sort=date_created, criteria=desc, range=date_created, begin_date, end_date, offset e limit.
+type PaymentConsultSearchParams request.QueryParams
+
+// PaymentConsultSearchResponse é a struct que contém os pagamentos retornados na busca de pagamentos da API de pagamentos (/v1/payments/search).
+type PaymentConsultSearchResponse struct {
+	Paging  Paging                   `json:"paging"`  // Informações da paginação da busca
+	Results []PaymentConsultResponse `json:"results"` // Pagamentos encontrados na busca
+
+	// Metadados da resposta HTTP (status, headers, x-request-id) e JSON original retornado pelo MercadoPago
+	Response ResponseMetadata `json:"-"`
+}
+
+// Paging é a struct que contém as informações da paginação das buscas do MercadoPago.
+type Paging struct {
+	Total  int `json:"total"`  // Total de resultados encontrados
+	Limit  int `json:"limit"`  // Quantidade máxima de resultados retornados na página
+	Offset int `json:"offset"` // Posição do primeiro resultado da página
+}
+
+///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ReportConfig é a struct que contém as configurações dos relatórios de liquidações (settlement_report) e de liberações (release_report) do MercadoPago.
+// Os campos booleanos são ponteiros para que seja possível desativar uma opção ao atualizar as configurações, os campos nulos não são enviados.
+type ReportConfig struct {
+	FileNamePrefix        string           `json:"file_name_prefix,omitempty"`        // Prefixo do nome dos arquivos gerados
+	Columns               []ReportColumn   `json:"columns,omitempty"`                 // Colunas do relatório, na ordem em que aparecem no arquivo
+	Separator             string           `json:"separator,omitempty"`               // Separador das colunas do CSV (, ou ;)
+	DisplayTimezone       string           `json:"display_timezone,omitempty"`        // Fuso horário das datas do relatório (exemplo: GMT-03)
+	ReportTranslation     string           `json:"report_translation,omitempty"`      // Idioma dos nomes das colunas (en, es ou pt), o SDK espera as colunas em inglês (padrão en)
+	NotificationEmailList []string         `json:"notification_email_list,omitempty"` // Emails que são notificados quando um relatório é gerado
+	Frequency             *ReportFrequency `json:"frequency,omitempty"`               // Frequência da geração automática dos relatórios
+	Scheduled             bool             `json:"scheduled,omitempty"`               // Indica se a geração automática está ativa (somente leitura)
+
+	// Configurações do relatório de liquidações (settlement_report)
+	ShowFeePrevision     *bool `json:"show_fee_prevision,omitempty"`     // Exibe a previsão das tarifas
+	ShowChargebackCancel *bool `json:"show_chargeback_cancel,omitempty"` // Exibe os cancelamentos de estornos
+	CouponDetailed       *bool `json:"coupon_detailed,omitempty"`        // Exibe os cupons de desconto em uma coluna separada
+	IncludeWithdraw      *bool `json:"include_withdraw,omitempty"`       // Inclui os saques
+	ShippingDetail       *bool `json:"shipping_detail,omitempty"`        // Exibe os custos de envio em uma coluna separada
+	RefundDetailed       *bool `json:"refund_detailed,omitempty"`        // Exibe o código de referência dos reembolsos
+
+	// Configurações do relatório de liberações (release_report)
+	IncludeWithdrawalAtEnd *bool `json:"include_withdrawal_at_end,omitempty"` // Inclui os saques no final do relatório
+	CheckAvailableBalance  *bool `json:"check_available_balance,omitempty"`   // Inclui o saldo disponível no início e no final do relatório
+	CompensateDetail       *bool `json:"compensate_detail,omitempty"`         // Exibe o detalhe das compensações
+	ExecuteAfterWithdrawal *bool `json:"execute_after_withdrawal,omitempty"`  // Gera o relatório automaticamente após cada saque
+
+	// Metadados da resposta HTTP (status, headers, x-request-id) e JSON original retornado pelo MercadoPago
+	Response ResponseMetadata `json:"-"`
+}
+
+// ReportColumn é a struct que contém o nome de uma coluna dos relatórios (exemplo: SOURCE_ID, EXTERNAL_REFERENCE, TRANSACTION_AMOUNT).
+type ReportColumn struct {
+	Key string `json:"key"` // Nome da coluna
+}
+
+// ReportFrequency é a struct que contém a frequência da geração automática dos relatórios.
+type ReportFrequency struct {
+	Type  string `json:"type"`  // Tipo da frequência (daily, weekly ou monthly)
+	Value int    `json:"value"` // Dia da semana (weekly) ou do mês (monthly) em que o relatório é gerado
+	Hour  int    `json:"hour"`  // Hora em que o relatório é gerado
+}
+
+// Report é a struct que contém as informações de um relatório gerado ou em geração no MercadoPago.
+// A criação do relatório retorna a tarefa de geração (com Status), a listagem retorna os arquivos já gerados (com FileName).
+type Report struct {
+	ID             int64  `json:"id"`              // Identificador do relatório
+	UserID         int64  `json:"user_id"`         // ID da conta do MercadoPago
+	BeginDate      Time   `json:"begin_date"`      // Data inicial do período do relatório
+	EndDate        Time   `json:"end_date"`        // Data final do período do relatório
+	FileName       string `json:"file_name"`       // Nome do arquivo usado no download (somente relatórios gerados)
+	CreatedFrom    string `json:"created_from"`    // Origem da geração (manual ou schedule)
+	Status         string `json:"status"`          // Status da geração (pending, processed...)
+	Format         string `json:"format"`          // Formato do arquivo (CSV)
+	DateCreated    Time   `json:"date_created"`    // Data de criação do relatório
+	GenerationDate Time   `json:"generation_date"` // Data em que o relatório foi ou será gerado
+	LastModified   Time   `json:"last_modified"`   // Data da última alteração do relatório
+
+	// Metadados da resposta HTTP (status, headers, x-request-id) e JSON original retornado pelo MercadoPago
+	Response ResponseMetadata `json:"-"`
+}
+
+///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// AccountBalance é a struct que contém o saldo da conta do MercadoPago.
+type AccountBalance struct {
+	UserID                            int64                      `json:"user_id"`                               // ID da conta do MercadoPago
+	CurrencyID                        string                     `json:"currency_id"`                           // Moeda do saldo
+	TotalAmount                       Money                      `json:"total_amount"`                          // Saldo total (disponível + indisponível)
+	AvailableBalance                  Money                      `json:"available_balance"`                     // Saldo disponível para saque e pagamentos
+	UnavailableBalance                Money                      `json:"unavailable_balance"`                   // Saldo ainda não liberado
+	UnavailableBalanceByReason        []BalanceByReason          `json:"unavailable_balance_by_reason"`         // Saldo indisponível separado pelo motivo (exemplo: dinheiro a liberar, disputas)
+	AvailableBalanceByTransactionType []BalanceByTransactionType `json:"available_balance_by_transaction_type"` // Saldo disponível separado pelo tipo da transação
+
+	// Metadados da resposta HTTP (status, headers, x-request-id) e JSON original retornado pelo MercadoPago
+	Response ResponseMetadata `json:"-"`
+}
+
+// BalanceByReason é a struct que contém o valor do saldo indisponível por um motivo.
+type BalanceByReason struct {
+	Reason string `json:"reason"` // Motivo do saldo estar indisponível (exemplo: time_period, dispute)
+	Amount Money  `json:"amount"` // Valor indisponível pelo motivo
+}
+
+// BalanceByTransactionType é a struct que contém o valor do saldo disponível de um tipo de transação.
+type BalanceByTransactionType struct {
+	TransactionType string `json:"transaction_type"` // Tipo da transação (exemplo: payment, withdrawal)
+	Amount          Money  `json:"amount"`           // Valor disponível do tipo da transação
+}
+
+// AccountMovementsSearchParams é um map[string]interface{} que contém os filtros usados na busca de movimentações da conta do MercadoPago.
+// Exemplos de filtros: user_id (obrigatório), type (income ou expense), detail, status, range=date_created, begin_date, end_date, offset e limit.
+type AccountMovementsSearchParams request.QueryParams
+
+// AccountMovementsSearchResponse é a struct que contém as movimentações retornadas na busca de movimentações da conta do MercadoPago.
+type AccountMovementsSearchResponse struct {
+	Paging  Paging            `json:"paging"`  // Informações da paginação da busca
+	Results []AccountMovement `json:"results"` // Movimentações encontradas na busca
+
+	// Metadados da resposta HTTP (status, headers, x-request-id) e JSON original retornado pelo MercadoPago
+	Response ResponseMetadata `json:"-"`
+}
+
+// AccountMovement é a struct que contém as informações de uma movimentação da conta do MercadoPago (entrada ou saída de dinheiro).
+type AccountMovement struct {
+	ID              int64          `json:"id"`               // Identificador da movimentação
+	UserID          int64          `json:"user_id"`          // ID da conta do MercadoPago
+	Type            string         `json:"type"`             // Tipo da movimentação (income ou expense)
+	Detail          string         `json:"detail"`           // Detalhe da movimentação (payment, fee, refund, withdrawal...)
+	Status          string         `json:"status"`           // Status do dinheiro da movimentação (available ou unavailable)
+	Amount          Money          `json:"amount"`           // Valor da movimentação (negativo nas saídas)
+	BalancedAmount  Money          `json:"balanced_amount"`  // Saldo da conta após a movimentação
+	CurrencyID      string         `json:"currency_id"`      // Moeda da movimentação
+	ReferenceID     FlexibleString `json:"reference_id"`     // ID do recurso que gerou a movimentação (exemplo: ID do pagamento)
+	FinancialEntity string         `json:"financial_entity"` // Entidade financeira da movimentação (exemplo: payment, withdrawal)
+	SiteID          string         `json:"site_id"`          // ID do site da conta
+	DateCreated     Time           `json:"date_created"`     // Data da movimentação
+	DateReleased    *Time          `json:"date_released"`    // Data em que o dinheiro foi ou será liberado
+}
+
+///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// TestUser é a struct que contém as informações de um usuário de testes criado no MercadoPago (comprador ou vendedor do sandbox).
+// A senha só é retornada na criação do usuário, por isso ela deve ser guardada.
+type TestUser struct {
+	ID         int64  `json:"id"`          // ID da conta de testes
+	Nickname   string `json:"nickname"`    // Apelido usado para entrar na conta de testes
+	Password   string `json:"password"`    // Senha da conta de testes
+	Email      string `json:"email"`       // Email da conta de testes
+	SiteStatus string `json:"site_status"` // Status da conta (active)
+	SiteID     string `json:"site_id"`     // ID do site (país) da conta de testes
+
+	// Metadados da resposta HTTP (status, headers, x-request-id) e JSON original retornado pelo MercadoPago
+	Response ResponseMetadata `json:"-"`
+}
+
+// User é a struct que contém as informações da conta do MercadoPago dona do access token.
+type User struct {
+	ID               int64               `json:"id"`                // ID da conta do MercadoPago (usado como collector_id e user_id)
+	Nickname         string              `json:"nickname"`          // Apelido da conta
+	FirstName        string              `json:"first_name"`        // Nome do titular da conta
+	LastName         string              `json:"last_name"`         // Sobrenome do titular da conta
+	Email            string              `json:"email"`             // Email da conta
+	CountryID        string              `json:"country_id"`        // País da conta (exemplo: BR)
+	SiteID           string              `json:"site_id"`           // ID do site da conta (exemplo: MLB)
+	UserType         string              `json:"user_type"`         // Tipo da conta (normal, brand...)
+	Identification   PayerIdentification `json:"identification"`    // Documento de identificação do titular da conta
+	Tags             []string            `json:"tags"`              // Tags da conta (exemplo: test_user, normal)
+	Permalink        string              `json:"permalink"`         // Link do perfil da conta
+	RegistrationDate Time                `json:"registration_date"` // Data de criação da conta
+	Status           UserStatus          `json:"status"`            // Status da conta
+
+	// Metadados da resposta HTTP (status, headers, x-request-id) e JSON original retornado pelo MercadoPago
+	Response ResponseMetadata `json:"-"`
+}
+
+// UserStatus é a struct que contém o status da conta do MercadoPago.
+type UserStatus struct {
+	SiteStatus string `json:"site_status"` // Status da conta no site (active, deactive...)
 }
 
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // ErrorResponse é a struct que é usada para receber os retornos de erro do MercadoPago.
 type ErrorResponse struct {
-	Error   string `json:"error"`   // Slug do erro que retornou
-	Message string `json:"message"` // Mensagem de erro relacinada ao campo
-	Status  int    `json:"status"`  // Status/Codigo do erro
+	Error   string       `json:"error"`   // Slug do erro que retornou
+	Message string       `json:"message"` // Mensagem de erro relacinada ao campo
+	Status  int          `json:"status"`  // Status/Codigo do erro
+	Cause   []ErrorCause `json:"cause"`   // Lista das causas do erro (exemplo: código 2067 - Invalid user identification number)
+
+	// Metadados da resposta HTTP (status, headers, x-request-id) e JSON original retornado pelo MercadoPago
+	Response ResponseMetadata `json:"-"`
+}
+
+// ErrorCause é a struct que contém as informações de uma das causas de um erro retornado pelo MercadoPago.
+// O código da causa é retornado as vezes como número e as vezes como string, por isso ele é sempre convertido para string.
+type ErrorCause struct {
+	Code        string `json:"code"`        // Código da causa do erro (exemplo: 2067)
+	Description string `json:"description"` // Descrição da causa do erro (em inglês)
 }
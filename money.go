@@ -0,0 +1,383 @@
+package mercadopago
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidMoney é o erro retornado quando não é possível converter um valor para Money.
+var ErrInvalidMoney = errors.New("mercadopago: valor monetário inválido")
+
+// currencyMinorUnits contém o número de casas decimais aceitas pelo MercadoPago em cada moeda (ISO-4217).
+// O peso chileno (CLP) e o peso colombiano (COP) não aceitam centavos no MercadoPago.
+var currencyMinorUnits = map[string]int32{
+	"ARS": 2,
+	"BRL": 2,
+	"CLP": 0,
+	"COP": 0,
+	"MXN": 2,
+	"PEN": 2,
+	"UYU": 2,
+	"USD": 2,
+	"VES": 2,
+}
+
+// CurrencyMinorUnits é a função que retorna o número de casas decimais de uma moeda (exemplo: BRL 2, CLP 0).
+// Caso a moeda não seja conhecida então retorna 2 casas decimais.
+func CurrencyMinorUnits(currencyID string) int32 {
+	if minorUnits, ok := currencyMinorUnits[strings.ToUpper(currencyID)]; ok {
+		return minorUnits
+	}
+	return 2
+}
+
+// Money é o tipo que representa um valor monetário decimal exato, sem os erros de arredondamento do float64.
+// O valor é armazenado como um inteiro sem casas decimais mais o número de casas decimais (exemplo: 10.50 é armazenado como 1050 com 2 casas),
+// dessa forma a representação numérica retornada pelo MercadoPago é preservada ao decodar e encodar o JSON.
+// O valor zero de Money é um valor válido que representa o número 0.
+type Money struct {
+	value int64 // Valor sem as casas decimais
+	scale int32 // Número de casas decimais do valor
+}
+
+// NewMoney é a função que cria um Money a partir de um float64 usando a menor representação decimal do número, ou seja,
+// NewMoney(49.9) resulta exatamente em 49.9 e não em 49.89999999999999857891452847979962825775146484375.
+// Valores que não podem ser representados como Money (NaN, infinito ou fora do limite do int64, exemplo: 1e300) resultam em zero,
+// por isso valores que não são constantes devem ser convertidos pelo NewMoneyFromFloat, que retorna o erro.
+func NewMoney(value float64) Money {
+	money, err := NewMoneyFromFloat(value)
+	if err != nil {
+		return Money{}
+	}
+	return money
+}
+
+// NewMoneyFromFloat é a função que cria um Money a partir de um float64 da mesma forma que o NewMoney, porém retorna ErrInvalidMoney
+// quando o valor não pode ser representado como Money (NaN, infinito ou fora do limite do int64).
+func NewMoneyFromFloat(value float64) (Money, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return Money{}, ErrInvalidMoney
+	}
+	return ParseMoney(strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+// NewMoneyFromMinorUnits é a função que cria um Money a partir do valor em centavos (ou na menor unidade da moeda).
+// Exemplo: NewMoneyFromMinorUnits(1050, "BRL") resulta em 10.50 e NewMoneyFromMinorUnits(1050, "CLP") resulta em 1050.
+func NewMoneyFromMinorUnits(units int64, currencyID string) Money {
+	return Money{value: units, scale: CurrencyMinorUnits(currencyID)}
+}
+
+// maxMoneyScale é o maior expoente e o maior número de casas decimais aceitos na conversão de um texto para Money.
+// Valores fora desse limite não são valores monetários válidos e causariam a criação de números gigantes em memória.
+const maxMoneyScale = 64
+
+// ParseMoney é a função que converte um número decimal em texto para Money (exemplo: "10.50", "-3", "1.5e2").
+// Expoentes e números de casas decimais maiores que 64 não são aceitos.
+func ParseMoney(value string) (Money, error) {
+	value = strings.TrimSpace(value)
+
+	exponent := int64(0)
+	if i := strings.IndexAny(value, "eE"); i >= 0 {
+		e, err := strconv.ParseInt(value[i+1:], 10, 32)
+		if err != nil || e > maxMoneyScale || e < -maxMoneyScale {
+			return Money{}, ErrInvalidMoney
+		}
+		value, exponent = value[:i], e
+	}
+
+	negative := false
+	if value != "" && (value[0] == '-' || value[0] == '+') {
+		negative, value = value[0] == '-', value[1:]
+	}
+
+	integer, fraction := value, ""
+	if i := strings.IndexByte(value, '.'); i >= 0 {
+		integer, fraction = value[:i], value[i+1:]
+	}
+
+	if integer+fraction == "" || onlyDigits(integer+fraction) != integer+fraction {
+		return Money{}, ErrInvalidMoney
+	}
+
+	unscaled, _ := new(big.Int).SetString(integer+fraction, 10)
+	if negative {
+		unscaled.Neg(unscaled)
+	}
+
+	scale := int64(len(fraction)) - exponent
+	if scale > maxMoneyScale {
+		return Money{}, ErrInvalidMoney
+	}
+	if scale < 0 {
+		unscaled.Mul(unscaled, pow10(int32(-scale)))
+		scale = 0
+	}
+
+	return fromBig(unscaled, int32(scale))
+}
+
+// MustParseMoney é a função que converte um número decimal em texto para Money, causando um panic caso o valor seja inválido.
+// Deve ser usada somente com valores constantes.
+func MustParseMoney(value string) Money {
+	money, err := ParseMoney(value)
+	if err != nil {
+		panic(err)
+	}
+	return money
+}
+
+// SumMoney é a função que soma todos os valores informados.
+func SumMoney(values ...Money) Money {
+	var sum Money
+	for _, value := range values {
+		sum = sum.Add(value)
+	}
+	return sum
+}
+
+// Add é o método que retorna a soma dos dois valores.
+func (money Money) Add(other Money) Money {
+	scale := maxScale(money.scale, other.scale)
+	result, _ := fromBig(new(big.Int).Add(money.rescale(scale), other.rescale(scale)), scale)
+	return result
+}
+
+// Sub é o método que retorna a subtração dos dois valores.
+func (money Money) Sub(other Money) Money {
+	return money.Add(other.Neg())
+}
+
+// Mul é o método que retorna a multiplicação dos dois valores (exemplo: valor x taxa).
+func (money Money) Mul(other Money) Money {
+	result, _ := fromBig(new(big.Int).Mul(big.NewInt(money.value), big.NewInt(other.value)), money.scale+other.scale)
+	return result.trim(maxScale(money.scale, other.scale))
+}
+
+// MulInt é o método que retorna o valor multiplicado por um número inteiro.
+func (money Money) MulInt(n int64) Money {
+	result, _ := fromBig(new(big.Int).Mul(big.NewInt(money.value), big.NewInt(n)), money.scale)
+	return result
+}
+
+// MulQuantity é o método que retorna o valor multiplicado por uma quantidade (exemplo: Item.UnitPrice.MulQuantity(Item.Quantity)).
+func (money Money) MulQuantity(quantity float64) Money {
+	return money.Mul(NewMoney(quantity))
+}
+
+// Allocate é o método que divide o valor em n partes iguais arredondadas de acordo com a moeda, distribuindo os centavos que sobram
+// nas primeiras partes, de forma que a soma das partes seja sempre igual ao valor arredondado (exemplo: 100.00 em 3 partes é 33.34, 33.33, 33.33).
+func (money Money) Allocate(n int, currencyID string) []Money {
+	if n <= 0 {
+		return nil
+	}
+
+	total := money.MinorUnits(currencyID)
+	part, remainder := total/int64(n), total%int64(n)
+
+	parts := make([]Money, n)
+	for i := range parts {
+		units := part
+		if int64(i) < remainder {
+			units++
+		} else if -int64(i) > remainder {
+			units--
+		}
+		parts[i] = NewMoneyFromMinorUnits(units, currencyID)
+	}
+	return parts
+}
+
+// Neg é o método que retorna o valor com o sinal invertido.
+func (money Money) Neg() Money {
+	if money.value == math.MinInt64 {
+		result, _ := fromBig(new(big.Int).Neg(big.NewInt(money.value)), money.scale)
+		return result
+	}
+	return Money{value: -money.value, scale: money.scale}
+}
+
+// Abs é o método que retorna o valor absoluto (sem sinal).
+func (money Money) Abs() Money {
+	if money.value < 0 {
+		return money.Neg()
+	}
+	return money
+}
+
+// Cmp é o método que compara os dois valores, retorna -1 caso o valor seja menor, 0 caso seja igual e +1 caso seja maior.
+func (money Money) Cmp(other Money) int {
+	scale := maxScale(money.scale, other.scale)
+	return money.rescale(scale).Cmp(other.rescale(scale))
+}
+
+// Equal é o método que indica se os dois valores são iguais, independente do número de casas decimais (10.5 é igual a 10.50).
+// Sempre use esse método para comparar valores, o operador == também compara o número de casas decimais.
+func (money Money) Equal(other Money) bool {
+	return money.Cmp(other) == 0
+}
+
+// Sign é o método que retorna -1 caso o valor seja negativo, 0 caso seja zero e +1 caso seja positivo.
+func (money Money) Sign() int {
+	switch {
+	case money.value < 0:
+		return -1
+	case money.value > 0:
+		return 1
+	}
+	return 0
+}
+
+// IsZero é o método que indica se o valor é zero.
+func (money Money) IsZero() bool {
+	return money.value == 0
+}
+
+// IsNegative é o método que indica se o valor é menor que zero.
+func (money Money) IsNegative() bool {
+	return money.value < 0
+}
+
+// IsPositive é o método que indica se o valor é maior que zero.
+func (money Money) IsPositive() bool {
+	return money.value > 0
+}
+
+// Round é o método que arredonda o valor para o número de casas decimais informado.
+// O arredondamento é comercial, ou seja, a partir da metade arredonda para longe do zero (exemplo: 0.125 vira 0.13 e -0.125 vira -0.13).
+func (money Money) Round(places int32) Money {
+	if places >= money.scale {
+		return money.rescaleMoney(places)
+	}
+
+	divisor := pow10(money.scale - places)
+	quotient, remainder := new(big.Int).QuoRem(big.NewInt(money.value), divisor, new(big.Int))
+
+	// Caso o resto seja maior ou igual a metade do divisor arredondamos para longe do zero.
+	if new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2)).Cmp(divisor) >= 0 {
+		quotient.Add(quotient, big.NewInt(int64(money.Sign())))
+	}
+
+	result, _ := fromBig(quotient, places)
+	return result
+}
+
+// RoundCurrency é o método que arredonda o valor para o número de casas decimais da moeda (exemplo: BRL 2 casas, CLP 0 casas).
+func (money Money) RoundCurrency(currencyID string) Money {
+	return money.Round(CurrencyMinorUnits(currencyID))
+}
+
+// MinorUnits é o método que retorna o valor arredondado em centavos (ou na menor unidade da moeda), exemplo: 10.50 BRL resulta em 1050.
+func (money Money) MinorUnits(currencyID string) int64 {
+	return money.RoundCurrency(currencyID).value
+}
+
+// Float64 é o método que retorna o valor como float64. Deve ser usado apenas para exibição ou cálculos que não precisam ser exatos.
+func (money Money) Float64() float64 {
+	value, _ := strconv.ParseFloat(money.String(), 64)
+	return value
+}
+
+// String é o método que retorna o valor no formato decimal mantendo o número de casas decimais (exemplo: 10.50).
+func (money Money) String() string {
+	digits := strconv.FormatInt(money.value, 10)
+	if money.scale <= 0 {
+		return digits
+	}
+
+	sign := ""
+	if money.value < 0 {
+		sign, digits = "-", digits[1:]
+	}
+
+	if len(digits) <= int(money.scale) {
+		digits = strings.Repeat("0", int(money.scale)-len(digits)+1) + digits
+	}
+
+	point := len(digits) - int(money.scale)
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+// MarshalJSON é o método que encoda o valor como um número JSON, preservando o número de casas decimais.
+func (money Money) MarshalJSON() ([]byte, error) {
+	return []byte(money.String()), nil
+}
+
+// UnmarshalJSON é o método que decoda o valor a partir de um número JSON. Também são aceitos números dentro de strings ("10.50"),
+// pois algumas APIs do MercadoPago retornam valores dessa forma, e o null que resulta no valor zero.
+func (money *Money) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*money = Money{}
+		return nil
+	}
+
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+		if len(bytes.TrimSpace(data)) == 0 {
+			*money = Money{}
+			return nil
+		}
+	}
+
+	parsed, err := ParseMoney(string(data))
+	if err != nil {
+		return err
+	}
+
+	*money = parsed
+	return nil
+}
+
+// rescale é o método que retorna o valor sem casas decimais convertido para um número maior de casas decimais.
+func (money Money) rescale(scale int32) *big.Int {
+	value := big.NewInt(money.value)
+	if scale > money.scale {
+		value.Mul(value, pow10(scale-money.scale))
+	}
+	return value
+}
+
+// rescaleMoney é o método que retorna o mesmo valor com um número maior de casas decimais (exemplo: 10.5 com 2 casas vira 10.50).
+func (money Money) rescaleMoney(scale int32) Money {
+	result, _ := fromBig(money.rescale(scale), scale)
+	return result
+}
+
+// trim é o método que remove os zeros a direita das casas decimais até o mínimo de casas decimais informado.
+func (money Money) trim(minScale int32) Money {
+	for money.scale > minScale && money.value%10 == 0 {
+		money.value /= 10
+		money.scale--
+	}
+	return money
+}
+
+// fromBig é a função que cria um Money a partir de um big.Int sem casas decimais. Caso o valor não caiba em um int64 então as casas decimais
+// menos significativas são arredondadas até que o valor caiba, e caso nem a parte inteira caiba então retorna ErrInvalidMoney.
+func fromBig(value *big.Int, scale int32) (Money, error) {
+	for !value.IsInt64() {
+		if scale <= 0 {
+			return Money{}, ErrInvalidMoney
+		}
+		value = new(big.Int).Quo(value.Add(value, new(big.Int).Mul(big.NewInt(int64(value.Sign())), big.NewInt(5))), big.NewInt(10))
+		scale--
+	}
+	return Money{value: value.Int64(), scale: scale}, nil
+}
+
+// pow10 é a função que retorna 10 elevado a n.
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// maxScale é a função que retorna o maior número de casas decimais.
+func maxScale(a, b int32) int32 {
+	if a > b {
+		return a
+	}
+	return b
+}
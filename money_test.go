@@ -0,0 +1,107 @@
+package mercadopago
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Testando a conversão de valores para Money e a preservação da representação numérica do MercadoPago
+func TestMoneyJSON(t *testing.T) {
+
+	var details TransactionDetails
+	err := json.Unmarshal([]byte(`{"total_paid_amount": 100.50, "installment_amount": "33.5", "net_received_amount": 95.51, "overpaid_amount": null}`), &details)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if details.TotalPaidAmount.String() != "100.50" || details.InstallmentAmount.String() != "33.5" || !details.OverpaidAmount.IsZero() {
+		t.Error("Valores decodados incorretamente!")
+		t.Error(details)
+	}
+
+	data, err := json.Marshal(Item{Title: "Mensalidade", Quantity: 1, UnitPrice: MustParseMoney("49.90")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var item map[string]json.RawMessage
+	json.Unmarshal(data, &item)
+	if string(item["unit_price"]) != "49.90" {
+		t.Error("Valor encodado incorretamente: " + string(item["unit_price"]))
+	}
+
+	for _, invalid := range []string{"", "abc", "1.2.3", "--1", "1e", "1e50000000", "1e-2147483648", "0." + strings.Repeat("1", 65)} {
+		if _, err := ParseMoney(invalid); err != ErrInvalidMoney {
+			t.Error("Era esperado erro ao converter o valor " + invalid)
+		}
+	}
+
+	if money := MustParseMoney("1.5e2"); money.String() != "150" {
+		t.Error("Valor com expoente convertido incorretamente: " + money.String())
+	}
+
+	for _, invalid := range []float64{math.NaN(), math.Inf(1), math.Inf(-1), 1e300} {
+		if _, err := NewMoneyFromFloat(invalid); err != ErrInvalidMoney {
+			t.Error("Era esperado erro ao converter o float " + strconv.FormatFloat(invalid, 'g', -1, 64))
+		}
+		if !NewMoney(invalid).IsZero() {
+			t.Error("O NewMoney deve retornar zero para valores inválidos!")
+		}
+	}
+
+	if money, err := NewMoneyFromFloat(49.9); err != nil || money.String() != "49.9" {
+		t.Error("Float convertido incorretamente: " + money.String())
+	}
+
+}
+
+// Testando as operações aritméticas e os arredondamentos do Money
+func TestMoneyArithmetic(t *testing.T) {
+
+	// Em float64 0.1 + 0.2 é 0.30000000000000004
+	if sum := NewMoney(0.1).Add(NewMoney(0.2)); sum.String() != "0.3" || !sum.Equal(MustParseMoney("0.30")) {
+		t.Error("Soma incorreta: " + sum.String())
+	}
+
+	total := SumMoney(MustParseMoney("19.99").MulQuantity(3), MustParseMoney("0.03"))
+	if total.String() != "60.00" {
+		t.Error("Total incorreto: " + total.String())
+	}
+
+	if fee := MustParseMoney("100.00").Mul(MustParseMoney("0.0499")); fee.String() != "4.9900" || fee.RoundCurrency("BRL").String() != "4.99" {
+		t.Error("Taxa incorreta: " + fee.String())
+	}
+
+	if net := MustParseMoney("100").Sub(MustParseMoney("4.99")); net.String() != "95.01" || net.Cmp(MustParseMoney("95")) != 1 {
+		t.Error("Valor liquido incorreto: " + net.String())
+	}
+
+	roundTests := map[string]string{"0.125": "0.13", "-0.125": "-0.13", "0.124": "0.12", "10.5": "10.50", "-0.004": "0.00"}
+	for value, expected := range roundTests {
+		if rounded := MustParseMoney(value).Round(2); rounded.String() != expected {
+			t.Error("Arredondamento incorreto de " + value + ": " + rounded.String())
+		}
+	}
+
+	if clp := MustParseMoney("1500.5").RoundCurrency("CLP"); clp.String() != "1501" {
+		t.Error("Arredondamento em CLP incorreto: " + clp.String())
+	}
+
+	if units := MustParseMoney("10.5").MinorUnits("BRL"); units != 1050 {
+		t.Error("Centavos incorretos!")
+	}
+
+	if money := NewMoneyFromMinorUnits(-5, "ARS"); money.String() != "-0.05" {
+		t.Error("Valor em centavos incorreto: " + money.String())
+	}
+
+	parts := MustParseMoney("100").Allocate(3, "BRL")
+	if len(parts) != 3 || parts[0].String() != "33.34" || parts[1].String() != "33.33" || !SumMoney(parts...).Equal(NewMoney(100)) {
+		t.Error("Divisão incorreta!")
+		t.Error(parts)
+	}
+
+}
@@ -0,0 +1,18 @@
+package mercadopago
+
+import "encoding/json"
+
+// UnmarshalJSON é o método que decoda a consulta de um pagamento guardando uma cópia do JSON original no campo RawJSON,
+// permitindo ler os campos retornados pelo MercadoPago que ainda não foram mapeados na struct.
+func (paymentConsultResponse *PaymentConsultResponse) UnmarshalJSON(data []byte) error {
+	type paymentConsultResponseAlias PaymentConsultResponse
+
+	var payment paymentConsultResponseAlias
+	if err := json.Unmarshal(data, &payment); err != nil {
+		return err
+	}
+
+	*paymentConsultResponse = PaymentConsultResponse(payment)
+	paymentConsultResponse.RawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
@@ -0,0 +1,103 @@
+package mercadopago
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+// Usado para regerar os arquivos .golden: go test -run TestPaymentConsultGolden -update
+var update = flag.Bool("update", false, "atualiza os arquivos .golden dos testes")
+
+// Testando o Unmarshal das respostas da consulta de pagamento (cartão, Pix, boleto e saldo em conta) comparando com os arquivos .golden.
+// Os arquivos de testdata/payments são sintéticos, montados a partir da documentação do MercadoPago e não gravados na API.
+func TestPaymentConsultGolden(t *testing.T) {
+
+	for _, name := range []string{"card", "pix", "boleto", "account_money"} {
+
+		data, err := ioutil.ReadFile(filepath.Join("testdata", "payments", name+".json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var payment PaymentConsultResponse
+		if err := json.Unmarshal(data, &payment); err != nil {
+			t.Error("Erro ao decodar o pagamento " + name + ": " + err.Error())
+			continue
+		}
+
+		if !bytes.Equal(payment.RawJSON, bytes.TrimSpace(data)) {
+			t.Error("JSON original não foi preservado no pagamento " + name)
+		}
+
+		decoded, err := json.MarshalIndent(payment, "", "  ")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		golden := filepath.Join("testdata", "payments", name+".golden")
+		if *update {
+			if err := ioutil.WriteFile(golden, append(decoded, '\n'), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		expected, err := ioutil.ReadFile(golden)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(append(decoded, '\n'), expected) {
+			t.Error("O pagamento " + name + " foi decodado diferente do arquivo " + golden)
+			t.Error(string(decoded))
+		}
+	}
+
+}
+
+// Testando os campos adicionais da consulta de pagamento
+func TestPaymentConsultFields(t *testing.T) {
+
+	var card, pix PaymentConsultResponse
+	for name, payment := range map[string]*PaymentConsultResponse{"card": &card, "pix": &pix} {
+		data, err := ioutil.ReadFile(filepath.Join("testdata", "payments", name+".json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(data, payment); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if card.Metadata["order_id"] != "ABC-123" || card.Order == nil || card.Order.ID != "4511021453" || card.IssuerID != "24" {
+		t.Error("Metadados ou ordem decodados incorretamente!")
+	}
+
+	if len(card.Refunds) != 1 || card.Refunds[0].Amount.String() != "10" || card.Refunds[0].Source.Type != "collector" {
+		t.Error("Reembolsos decodados incorretamente!")
+	}
+
+	if len(card.ChargesDetails) != 1 || card.ChargesDetails[0].Amounts.Original.String() != "4.99" || card.PaymentMethod.Type != PaymentTypeCreditCard {
+		t.Error("Cobranças ou método de pagamento decodados incorretamente!")
+	}
+
+	if card.MoneyReleaseStatus == nil || *card.MoneyReleaseStatus != "pending" {
+		t.Error("Situação da liberação do dinheiro decodada incorretamente!")
+	}
+
+	transactionData := pix.PointOfInteraction.TransactionData
+	if transactionData.TicketURL == "" || transactionData.BankInfo == nil || transactionData.BankInfo.Collector.AccountID != "12345" || transactionData.BankInfo.IsSameBankAccountOwner != "false" {
+		t.Error("Dados do Pix decodados incorretamente!")
+	}
+
+	// Campos que não foram mapeados continuam disponíveis no JSON original
+	var raw map[string]interface{}
+	json.Unmarshal(card.RawJSON, &raw)
+	if _, ok := raw["campaign_id"]; !ok {
+		t.Error("Campo não mapeado não encontrado no JSON original!")
+	}
+
+}
@@ -0,0 +1,94 @@
+package mercadopago
+
+import (
+	"encoding/base64"
+	"errors"
+
+	"github.com/eduardo-mior/mercadopago-sdk-go/internal/qrcode"
+	"github.com/eduardo-mior/mercadopago-sdk-go/pix"
+)
+
+// PixQRCodeSize é o tamanho padrão em pixels da imagem do QRCode do Pix gerada localmente.
+const PixQRCodeSize = 300
+
+// ErrEmptyPixCode é o erro retornado quando a cobrança não possui a chave Pix Copia-e-Cola.
+var ErrEmptyPixCode = errors.New("mercadopago: chave Pix Copia-e-Cola vazia")
+
+// Validate é o método que valida localmente uma cobrança Pix antes de enviar para o MercadoPago.
+// São validados o site do pagamento (o Pix só existe no Brasil, é usado o SiteID ou o site configurado), o valor positivo, o email do pagador (obrigatório no Pix), o documento de identificação e a URL de notificação.
+func (pixPaymentRequest PixPaymentRequest) Validate() error {
+	var validationErrors ValidationErrors
+
+	if !pixPaymentRequest.TransactionAmount.IsPositive() {
+		validationErrors.add("transaction_amount", ValidationInvalidValue, "o valor da cobrança deve ser maior que zero")
+	}
+
+	site := requestSite(pixPaymentRequest.SiteID)
+	if site.Known() && site.ID != SiteBrazil {
+		validationErrors.add("payment_method_id", ValidationInvalidValue, "o Pix só está disponível no site "+SiteBrazil+" (Brasil)")
+	}
+
+	validationErrors.addEmail("payer.email", pixPaymentRequest.Payer.Email)
+
+	validationErrors.addPayerIdentification("payer.identification", pixPaymentRequest.Payer.Identification, nil, site)
+	validationErrors.addURL("notification_url", pixPaymentRequest.NotificationURL)
+
+	return validationErrors.err()
+}
+
+// QRCodeImage é o método que gera localmente a imagem PNG do QRCode da cobrança no tamanho informado (em pixels).
+func (pixCharge PixCharge) QRCodeImage(size int) ([]byte, error) {
+	return PixQRCode(pixCharge.QrCode, size)
+}
+
+// BRCode é o método que lê e valida (inclusive o CRC16) a chave Pix Copia-e-Cola da cobrança, retornando o recebedor, o valor, o txid e a URL do PSP.
+func (pixCharge PixCharge) BRCode() (*pix.BRCode, error) {
+	return pix.Parse(pixCharge.QrCode)
+}
+
+// PixQRCode é a função que gera localmente a imagem PNG do QRCode de uma chave Pix Copia-e-Cola (código EMV), com aproximadamente size pixels de largura e altura.
+// O QRCode é gerado com nível de correção de erros M, como recomendado pelo manual do BR Code do Banco Central.
+func PixQRCode(emv string, size int) ([]byte, error) {
+	if emv == "" {
+		return nil, ErrEmptyPixCode
+	}
+
+	if size <= 0 {
+		size = PixQRCodeSize
+	}
+
+	code, err := qrcode.Encode([]byte(emv), qrcode.LevelM)
+	if err != nil {
+		return nil, err
+	}
+	return code.PNG(size)
+}
+
+// newPixCharge é a função que monta a cobrança Pix a partir do pagamento retornado pelo MercadoPago, decodando a imagem do QRCode.
+func newPixCharge(payment PaymentConsultResponse) (*PixCharge, error) {
+	pixCharge := &PixCharge{
+		ID:               payment.ID,
+		Status:           payment.Status,
+		StatusDetail:     payment.StatusDetail,
+		DateOfExpiration: payment.DateOfExpiration,
+		Payment:          payment,
+	}
+
+	if payment.PointOfInteraction == nil || payment.PointOfInteraction.TransactionData == nil {
+		return pixCharge, nil
+	}
+
+	transactionData := payment.PointOfInteraction.TransactionData
+	pixCharge.QrCode = transactionData.QrCode
+	pixCharge.TicketURL = transactionData.TicketURL
+
+	if transactionData.QrCodeBase64 != "" {
+		image, err := base64.StdEncoding.DecodeString(transactionData.QrCodeBase64)
+		if err != nil {
+			return pixCharge, err
+		}
+		pixCharge.QrCodePNG = image
+	}
+
+	return pixCharge, nil
+}
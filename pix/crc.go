@@ -0,0 +1,35 @@
+package pix
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CRC16 é a função que calcula o CRC16-CCITT (polinômio 0x1021, valor inicial 0xFFFF) do payload, no formato de 4 caracteres hexadecimais maiúsculos.
+// O CRC do BR Code é calculado sobre todo o payload até o ID e o tamanho do campo do CRC, inclusive (exemplo: ...6304).
+func CRC16(payload string) string {
+	crc := uint16(0xFFFF)
+	for i := 0; i < len(payload); i++ {
+		crc ^= uint16(payload[i]) << 8
+		for bit := 0; bit < 8; bit++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return fmt.Sprintf("%04X", crc)
+}
+
+// ValidateCRC é a função que valida o CRC16 de um código Pix Copia-e-Cola. O CRC deve ser o último campo do payload (ID 63 com tamanho 04).
+func ValidateCRC(payload string) error {
+	if len(payload) < 8 || payload[len(payload)-8:len(payload)-4] != IDCRC+"04" {
+		return fieldError(IDCRC, ErrMissingField)
+	}
+
+	if !strings.EqualFold(CRC16(payload[:len(payload)-4]), payload[len(payload)-4:]) {
+		return ErrInvalidCRC
+	}
+	return nil
+}
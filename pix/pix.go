@@ -0,0 +1,299 @@
+// Package pix contém as funções de leitura, validação e geração dos códigos Pix Copia-e-Cola (BR Code),
+// que seguem o padrão EMV-MPM (Merchant Presented Mode) definido pelo Banco Central do Brasil.
+package pix
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// GUI é o identificador do arranjo Pix dentro do template de informações da conta do recebedor (campo 26).
+const GUI = "br.gov.bcb.pix"
+
+// Identificadores dos campos de primeiro nível do BR Code
+const (
+	IDPayloadFormatIndicator  = "00" // Versão do payload (sempre 01)
+	IDPointOfInitiationMethod = "01" // 11 para QRCode estático e 12 para QRCode que só pode ser pago uma vez
+	IDMerchantAccount         = "26" // Informações da conta do recebedor (template)
+	IDMerchantCategoryCode    = "52" // Código da categoria do recebedor (MCC)
+	IDTransactionCurrency     = "53" // Moeda da transação no formato ISO-4217 numérico (986 para BRL)
+	IDTransactionAmount       = "54" // Valor da transação
+	IDCountryCode             = "58" // Código do país no formato ISO-3166 (BR)
+	IDMerchantName            = "59" // Nome do recebedor
+	IDMerchantCity            = "60" // Cidade do recebedor
+	IDPostalCode              = "61" // CEP do recebedor
+	IDAdditionalData          = "62" // Dados adicionais (template)
+	IDCRC                     = "63" // CRC16 do payload
+)
+
+// Identificadores dos campos dos templates do BR Code
+const (
+	IDMerchantAccountGUI         = "00" // GUI do arranjo (br.gov.bcb.pix)
+	IDMerchantAccountKey         = "01" // Chave Pix do recebedor (QRCode estático)
+	IDMerchantAccountDescription = "02" // Informações adicionais para o pagador (QRCode estático)
+	IDMerchantAccountURL         = "25" // URL do PSP com o payload da cobrança, sem o https:// (QRCode dinâmico)
+	IDAdditionalDataTxID         = "05" // Identificador da transação (txid)
+)
+
+var (
+	// ErrInvalidPayload é o erro retornado quando o código não segue o formato TLV (ID, tamanho e valor) do EMV.
+	ErrInvalidPayload = errors.New("pix: payload EMV inválido")
+	// ErrInvalidCRC é o erro retornado quando o CRC16 do código não confere.
+	ErrInvalidCRC = errors.New("pix: CRC16 inválido")
+	// ErrMissingField é o erro retornado quando um campo obrigatório do BR Code não foi encontrado.
+	ErrMissingField = errors.New("pix: campo obrigatório não encontrado")
+	// ErrInvalidField é o erro retornado quando um campo do BR Code possui um valor inválido.
+	ErrInvalidField = errors.New("pix: campo inválido")
+)
+
+// Field é a struct que contém um campo TLV do BR Code. Os campos de template (26 a 51, 62 e 80 a 99) também possuem os campos internos em Fields.
+type Field struct {
+	ID     string  // Identificador do campo (2 digitos)
+	Value  string  // Valor do campo
+	Fields []Field // Campos internos dos templates
+}
+
+// Find é a função que retorna o valor do campo com o ID informado em uma lista de campos.
+func Find(fields []Field, id string) (string, bool) {
+	for _, field := range fields {
+		if field.ID == id {
+			return field.Value, true
+		}
+	}
+	return "", false
+}
+
+// BRCode é a struct que contém as informações de um código Pix Copia-e-Cola já lido e validado.
+type BRCode struct {
+	PayloadFormatIndicator  string  // Versão do payload (01)
+	PointOfInitiationMethod string  // 11 para QRCode estático, 12 para QRCode que só pode ser pago uma vez (vazio é igual a 11)
+	Key                     string  // Chave Pix do recebedor (somente QRCode estático)
+	Description             string  // Informações adicionais para o pagador (somente QRCode estático)
+	URL                     string  // URL do PSP com o payload da cobrança, sem o https:// (somente QRCode dinâmico)
+	MerchantCategoryCode    string  // Código da categoria do recebedor (MCC)
+	TransactionCurrency     string  // Moeda da transação (986 para BRL)
+	TransactionAmount       string  // Valor da transação (vazio quando o valor é definido pelo pagador)
+	CountryCode             string  // Código do país (BR)
+	MerchantName            string  // Nome do recebedor
+	MerchantCity            string  // Cidade do recebedor
+	PostalCode              string  // CEP do recebedor
+	TxID                    string  // Identificador da transação (*** quando não informado)
+	CRC                     string  // CRC16 do payload
+	Fields                  []Field // Todos os campos do payload, inclusive os que não foram mapeados
+}
+
+// IsDynamic é o método que indica se o BR Code é dinâmico, ou seja, se os dados da cobrança devem ser buscados na URL do PSP.
+func (brCode BRCode) IsDynamic() bool {
+	return brCode.URL != ""
+}
+
+// IsSingleUse é o método que indica se o BR Code só pode ser pago uma vez (ponto de iniciação 12).
+func (brCode BRCode) IsSingleUse() bool {
+	return brCode.PointOfInitiationMethod == "12"
+}
+
+// Amount é o método que retorna o valor da transação em centavos. Retorna false quando o valor não foi informado no BR Code.
+func (brCode BRCode) Amount() (int64, bool) {
+	if brCode.TransactionAmount == "" {
+		return 0, false
+	}
+	cents, err := parseAmount(brCode.TransactionAmount)
+	return cents, err == nil
+}
+
+// Parse é a função que lê um código Pix Copia-e-Cola, validando a estrutura TLV, o CRC16 e os campos obrigatórios do BR Code.
+func Parse(payload string) (*BRCode, error) {
+	payload = strings.TrimSpace(payload)
+
+	if err := ValidateCRC(payload); err != nil {
+		return nil, err
+	}
+
+	fields, err := parseFields(payload, true)
+	if err != nil {
+		return nil, err
+	}
+
+	brCode := &BRCode{Fields: fields}
+	for _, field := range fields {
+		switch field.ID {
+		case IDPayloadFormatIndicator:
+			brCode.PayloadFormatIndicator = field.Value
+		case IDPointOfInitiationMethod:
+			brCode.PointOfInitiationMethod = field.Value
+		case IDMerchantCategoryCode:
+			brCode.MerchantCategoryCode = field.Value
+		case IDTransactionCurrency:
+			brCode.TransactionCurrency = field.Value
+		case IDTransactionAmount:
+			brCode.TransactionAmount = field.Value
+		case IDCountryCode:
+			brCode.CountryCode = field.Value
+		case IDMerchantName:
+			brCode.MerchantName = field.Value
+		case IDMerchantCity:
+			brCode.MerchantCity = field.Value
+		case IDPostalCode:
+			brCode.PostalCode = field.Value
+		case IDAdditionalData:
+			brCode.TxID, _ = Find(field.Fields, IDAdditionalDataTxID)
+		case IDCRC:
+			brCode.CRC = field.Value
+		default:
+			// As informações da conta do recebedor podem estar em qualquer template entre 26 e 51, identificado pelo GUI do Pix.
+			if isMerchantAccount(field.ID) {
+				if gui, _ := Find(field.Fields, IDMerchantAccountGUI); strings.EqualFold(gui, GUI) {
+					brCode.Key, _ = Find(field.Fields, IDMerchantAccountKey)
+					brCode.Description, _ = Find(field.Fields, IDMerchantAccountDescription)
+					brCode.URL, _ = Find(field.Fields, IDMerchantAccountURL)
+				}
+			}
+		}
+	}
+
+	if err := brCode.validate(); err != nil {
+		return nil, err
+	}
+	return brCode, nil
+}
+
+// validate é o método que valida os campos obrigatórios e o formato dos campos do BR Code.
+func (brCode BRCode) validate() error {
+	if brCode.Fields[0].ID != IDPayloadFormatIndicator || brCode.PayloadFormatIndicator != "01" {
+		return fieldError(IDPayloadFormatIndicator, ErrInvalidField)
+	}
+
+	if brCode.PointOfInitiationMethod != "" && brCode.PointOfInitiationMethod != "11" && brCode.PointOfInitiationMethod != "12" {
+		return fieldError(IDPointOfInitiationMethod, ErrInvalidField)
+	}
+
+	if brCode.Key == "" && brCode.URL == "" {
+		return fieldError(IDMerchantAccount, ErrMissingField)
+	}
+
+	required := map[string]string{
+		IDMerchantCategoryCode: brCode.MerchantCategoryCode,
+		IDTransactionCurrency:  brCode.TransactionCurrency,
+		IDCountryCode:          brCode.CountryCode,
+		IDMerchantName:         brCode.MerchantName,
+		IDMerchantCity:         brCode.MerchantCity,
+	}
+	for _, id := range []string{IDMerchantCategoryCode, IDTransactionCurrency, IDCountryCode, IDMerchantName, IDMerchantCity} {
+		if required[id] == "" {
+			return fieldError(id, ErrMissingField)
+		}
+	}
+
+	if brCode.TransactionAmount != "" {
+		if _, err := parseAmount(brCode.TransactionAmount); err != nil {
+			return fieldError(IDTransactionAmount, ErrInvalidField)
+		}
+	}
+
+	return nil
+}
+
+// parseFields é a função que lê uma sequência de campos TLV. Os templates são lidos recursivamente quando nested é verdadeiro.
+func parseFields(payload string, nested bool) ([]Field, error) {
+	var fields []Field
+	for len(payload) > 0 {
+		if len(payload) < 4 {
+			return nil, ErrInvalidPayload
+		}
+
+		id := payload[:2]
+		length, err := strconv.Atoi(payload[2:4])
+		if err != nil || !isDigits(id) || !isDigits(payload[2:4]) {
+			return nil, ErrInvalidPayload
+		}
+
+		value, rest, ok := cutRunes(payload[4:], length)
+		if !ok {
+			return nil, ErrInvalidPayload
+		}
+
+		field := Field{ID: id, Value: value}
+		if nested && isTemplate(id) {
+			if field.Fields, err = parseFields(value, false); err != nil {
+				return nil, err
+			}
+		}
+
+		fields = append(fields, field)
+		payload = rest
+	}
+	return fields, nil
+}
+
+// cutRunes é a função que separa os primeiros n caracteres do texto. O tamanho dos campos do BR Code é contado em caracteres e não em bytes.
+func cutRunes(text string, n int) (string, string, bool) {
+	i := 0
+	for count := 0; count < n; count++ {
+		if i >= len(text) {
+			return "", "", false
+		}
+		_, size := utf8.DecodeRuneInString(text[i:])
+		i += size
+	}
+	return text[:i], text[i:], true
+}
+
+// isTemplate é a função que indica se o campo é um template, ou seja, se o valor é composto por outros campos TLV.
+func isTemplate(id string) bool {
+	number, _ := strconv.Atoi(id)
+	return isMerchantAccount(id) || id == IDAdditionalData || (number >= 80 && number <= 99)
+}
+
+// isMerchantAccount é a função que indica se o campo é um template de informações da conta do recebedor (26 a 51).
+func isMerchantAccount(id string) bool {
+	number, _ := strconv.Atoi(id)
+	return number >= 26 && number <= 51
+}
+
+// parseAmount é a função que converte o valor da transação (exemplo: 10.50) em centavos.
+func parseAmount(amount string) (int64, error) {
+	integer, fraction := amount, ""
+	if dot := strings.IndexByte(amount, '.'); dot >= 0 {
+		integer, fraction = amount[:dot], amount[dot+1:]
+	}
+
+	if integer == "" || len(fraction) > 2 || len(amount) > 13 || !isDigits(integer) || !isDigits(fraction) {
+		return 0, ErrInvalidField
+	}
+
+	fraction += strings.Repeat("0", 2-len(fraction))
+	return strconv.ParseInt(integer+fraction, 10, 64)
+}
+
+// isDigits é a função que indica se o texto possui somente digitos.
+func isDigits(text string) bool {
+	for _, char := range text {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// fieldError é a função que adiciona o ID do campo na mensagem de um erro, mantendo o erro original acessível pelo errors.Is.
+func fieldError(id string, err error) error {
+	return &FieldError{ID: id, Err: err}
+}
+
+// FieldError é o erro retornado quando um campo do BR Code é inválido ou não foi encontrado.
+type FieldError struct {
+	ID  string // Identificador do campo
+	Err error  // Erro original (ErrMissingField ou ErrInvalidField)
+}
+
+// Error é o método que retorna a mensagem do erro com o ID do campo.
+func (fieldError *FieldError) Error() string {
+	return fieldError.Err.Error() + " (" + fieldError.ID + ")"
+}
+
+// Unwrap é o método que retorna o erro original, permitindo usar errors.Is(err, pix.ErrMissingField).
+func (fieldError *FieldError) Unwrap() error {
+	return fieldError.Err
+}
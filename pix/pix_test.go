@@ -0,0 +1,154 @@
+package pix
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// Exemplo de BR Code estático do manual do Banco Central
+const example = "00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63041D3D"
+
+// Testando o cálculo e a validação do CRC16
+func TestCRC16(t *testing.T) {
+
+	if crc := CRC16(example[:len(example)-4]); crc != "1D3D" {
+		t.Error("CRC16 calculado incorretamente: " + crc)
+	}
+
+	if err := ValidateCRC(example); err != nil {
+		t.Error(err)
+	}
+
+	if err := ValidateCRC(example[:len(example)-1] + "E"); err != ErrInvalidCRC {
+		t.Error("Era esperado erro de CRC16 inválido!")
+	}
+
+	if err := ValidateCRC("000201"); !errors.Is(err, ErrMissingField) {
+		t.Error("Era esperado erro de CRC16 não encontrado!")
+	}
+
+}
+
+// Testando a leitura de um BR Code estático e de um dinâmico
+func TestParse(t *testing.T) {
+
+	brCode, err := Parse(example)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if brCode.Key != "123e4567-e12b-12d1-a456-426655440000" || brCode.MerchantName != "Fulano de Tal" || brCode.MerchantCity != "BRASILIA" || brCode.TxID != "***" {
+		t.Error("BR Code lido incorretamente!")
+		t.Error(brCode)
+	}
+
+	if brCode.IsDynamic() || brCode.IsSingleUse() || brCode.TransactionCurrency != "986" || brCode.CountryCode != "BR" {
+		t.Error("BR Code lido incorretamente!")
+	}
+
+	if _, ok := brCode.Amount(); ok {
+		t.Error("O BR Code de exemplo não possui valor!")
+	}
+
+	// BR Code dinâmico no formato retornado pelo MercadoPago
+	payload := "00020101021226940014br.gov.bcb.pix2572pix-qr.mercadopago.com/instore/o/v2/6d2a3b6c-7a8e-4a8b-9e0e-7c1f2b3c4d5e5204000053039865406123.455802BR5912MERCADO PAGO6009SAO PAULO62070503***6304"
+	payload += CRC16(payload)
+
+	brCode, err = Parse(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !brCode.IsDynamic() || !brCode.IsSingleUse() || brCode.URL != "pix-qr.mercadopago.com/instore/o/v2/6d2a3b6c-7a8e-4a8b-9e0e-7c1f2b3c4d5e" {
+		t.Error("BR Code dinâmico lido incorretamente!")
+		t.Error(brCode)
+	}
+
+	if amount, ok := brCode.Amount(); !ok || amount != 12345 {
+		t.Error("Valor lido incorretamente!")
+	}
+
+	invalid := map[string]error{
+		"0002012658": ErrMissingField,
+		"00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63041D3E": ErrInvalidCRC,
+	}
+	for payload, expected := range invalid {
+		if _, err := Parse(payload); !errors.Is(err, expected) {
+			t.Error("Erro inesperado ao ler " + payload)
+		}
+	}
+
+	// Payload sem o nome do recebedor e com tamanho de campo inválido
+	withoutName := "00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR6008BRASILIA62070503***6304"
+	if _, err := Parse(withoutName + CRC16(withoutName)); !errors.Is(err, ErrMissingField) {
+		t.Error("Era esperado erro de campo obrigatório não encontrado!")
+	}
+
+	invalidLength := "00020126990014br.gov.bcb.pix6304"
+	if _, err := Parse(invalidLength + CRC16(invalidLength)); err != ErrInvalidPayload {
+		t.Error("Era esperado erro de payload inválido!")
+	}
+
+}
+
+// Testando a geração de um BR Code estático
+func TestStaticEncode(t *testing.T) {
+
+	payload, err := Static{
+		Key:          "123e4567-e12b-12d1-a456-426655440000",
+		MerchantName: "Fulano de Tal",
+		MerchantCity: "BRASILIA",
+	}.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if payload != example {
+		t.Error("BR Code gerado diferente do exemplo do manual: " + payload)
+	}
+
+	payload, err = Static{
+		Key:          "eduardo@email.com",
+		Description:  "Mensalidade",
+		MerchantName: "João da Conceição Araújo Sobrinho",
+		MerchantCity: "São José dos Campos",
+		Amount:       4990,
+		TxID:         "PEDIDO123",
+	}.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	brCode, err := Parse(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if brCode.MerchantName != "Joao da Conceicao Araujo" || brCode.MerchantCity != "Sao Jose dos Ca" || brCode.TransactionAmount != "49.90" || brCode.TxID != "PEDIDO123" || brCode.Description != "Mensalidade" {
+		t.Error("BR Code gerado incorretamente!")
+		t.Error(brCode)
+	}
+
+	if _, err := (Static{Key: "chave", MerchantName: "Fulano", MerchantCity: "Cidade", TxID: "pedido-1"}).Encode(); !errors.Is(err, ErrInvalidField) {
+		t.Error("Era esperado erro de txid inválido!")
+	}
+
+	if _, err := (Static{Key: "chave", MerchantName: "Fulano", MerchantCity: "Cidade", PostalCode: strings.Repeat("1", 100)}).Encode(); !errors.Is(err, ErrInvalidField) {
+		t.Error("Era esperado erro de CEP inválido!")
+	}
+
+	if _, err := (Static{Key: "chave", MerchantName: "Fulano", MerchantCity: "Cidade", Description: strings.Repeat("a", 100)}).Encode(); !errors.Is(err, ErrInvalidField) {
+		t.Error("Era esperado erro de campo com mais de 99 caracteres!")
+	}
+
+	payload, err = Static{Key: "chave", MerchantName: "Fulano", MerchantCity: "Cidade", PostalCode: "01310-100"}.Encode()
+	if brCode, parseErr := Parse(payload); err != nil || parseErr != nil || brCode.PostalCode != "01310100" {
+		t.Error("CEP do recebedor gerado incorretamente!")
+	}
+
+	if _, err := (Static{MerchantName: "Fulano", MerchantCity: "Cidade"}).Encode(); !errors.Is(err, ErrMissingField) {
+		t.Error("Era esperado erro de chave Pix não informada!")
+	}
+
+}
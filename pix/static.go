@@ -0,0 +1,161 @@
+package pix
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Tamanhos máximos dos campos do BR Code estático definidos pelo manual do Banco Central
+const (
+	MaxMerchantNameLength = 25 // Tamanho máximo do nome do recebedor
+	MaxMerchantCityLength = 15 // Tamanho máximo da cidade do recebedor
+	MaxTxIDLength         = 25 // Tamanho máximo do identificador da transação
+	MaxFieldLength        = 99 // Tamanho máximo do valor de qualquer campo TLV do BR Code
+	PostalCodeLength      = 8  // Tamanho do CEP do recebedor
+)
+
+// accents é usado para remover os acentos do nome e da cidade do recebedor, que devem ser enviados sem acentos no BR Code.
+var accents = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a", "é", "e", "è", "e", "ê", "e", "ë", "e", "í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o", "ú", "u", "ù", "u", "û", "u", "ü", "u", "ç", "c", "ñ", "n",
+	"Á", "A", "À", "A", "Â", "A", "Ã", "A", "Ä", "A", "É", "E", "È", "E", "Ê", "E", "Ë", "E", "Í", "I", "Ì", "I", "Î", "I", "Ï", "I",
+	"Ó", "O", "Ò", "O", "Ô", "O", "Õ", "O", "Ö", "O", "Ú", "U", "Ù", "U", "Û", "U", "Ü", "U", "Ç", "C", "Ñ", "N",
+)
+
+// Static é a struct que contém as informações usadas para gerar um BR Code estático (QRCode Pix com uma chave Pix fixa).
+type Static struct {
+	Key          string // Chave Pix do recebedor (CPF, CNPJ, email, telefone ou chave aleatória) (obrigatório)
+	Description  string // Informações adicionais para o pagador
+	MerchantName string // Nome do recebedor (obrigatório, até 25 caracteres)
+	MerchantCity string // Cidade do recebedor (obrigatório, até 15 caracteres)
+	PostalCode   string // CEP do recebedor (8 digitos, com ou sem o traço)
+	Amount       int64  // Valor em centavos (zero para o pagador informar o valor)
+	TxID         string // Identificador da transação (somente letras e números, até 25 caracteres, padrão ***)
+	SingleUse    bool   // Indica que o QRCode só pode ser pago uma vez
+}
+
+// Encode é o método que gera o código Pix Copia-e-Cola (BR Code) estático, com o CRC16 calculado.
+// O nome e a cidade do recebedor têm os acentos removidos e são truncados no tamanho máximo permitido.
+func (static Static) Encode() (string, error) {
+	key := strings.TrimSpace(static.Key)
+	if key == "" {
+		return "", fieldError(IDMerchantAccountKey, ErrMissingField)
+	}
+
+	merchantName := strings.TrimSpace(truncate(accents.Replace(strings.TrimSpace(static.MerchantName)), MaxMerchantNameLength))
+	if merchantName == "" {
+		return "", fieldError(IDMerchantName, ErrMissingField)
+	}
+
+	merchantCity := strings.TrimSpace(truncate(accents.Replace(strings.TrimSpace(static.MerchantCity)), MaxMerchantCityLength))
+	if merchantCity == "" {
+		return "", fieldError(IDMerchantCity, ErrMissingField)
+	}
+
+	txID := static.TxID
+	if txID == "" {
+		txID = "***"
+	} else if len(txID) > MaxTxIDLength || !isAlphanumeric(txID) {
+		return "", fieldError(IDAdditionalDataTxID, ErrInvalidField)
+	}
+
+	if static.Amount < 0 {
+		return "", fieldError(IDTransactionAmount, ErrInvalidField)
+	}
+
+	postalCode := strings.ReplaceAll(strings.TrimSpace(static.PostalCode), "-", "")
+	if postalCode != "" && (len(postalCode) != PostalCodeLength || !isNumeric(postalCode)) {
+		return "", fieldError(IDPostalCode, ErrInvalidField)
+	}
+
+	// O primeiro erro de tamanho dos campos é guardado e retornado antes do cálculo do CRC16.
+	var err error
+	field := func(id, value string) string {
+		encoded, fieldErr := tlv(id, value)
+		if err == nil {
+			err = fieldErr
+		}
+		return encoded
+	}
+
+	merchantAccount := field(IDMerchantAccountGUI, GUI) + field(IDMerchantAccountKey, key)
+	if static.Description != "" {
+		merchantAccount += field(IDMerchantAccountDescription, static.Description)
+	}
+
+	payload := field(IDPayloadFormatIndicator, "01")
+	if static.SingleUse {
+		payload += field(IDPointOfInitiationMethod, "12")
+	}
+	payload += field(IDMerchantAccount, merchantAccount)
+	payload += field(IDMerchantCategoryCode, "0000")
+	payload += field(IDTransactionCurrency, "986")
+	if static.Amount > 0 {
+		payload += field(IDTransactionAmount, formatAmount(static.Amount))
+	}
+	payload += field(IDCountryCode, "BR")
+	payload += field(IDMerchantName, merchantName)
+	payload += field(IDMerchantCity, merchantCity)
+	if postalCode != "" {
+		payload += field(IDPostalCode, postalCode)
+	}
+	payload += field(IDAdditionalData, field(IDAdditionalDataTxID, txID))
+	payload += IDCRC + "04"
+
+	if err != nil {
+		return "", err
+	}
+
+	return payload + CRC16(payload), nil
+}
+
+// tlv é a função que monta um campo no formato ID, tamanho (2 digitos) e valor.
+// Caso o valor possua mais de 99 caracteres então retorna um erro, já que o tamanho não cabe em 2 digitos.
+func tlv(id, value string) (string, error) {
+	length := utf8.RuneCountInString(value)
+	if length > MaxFieldLength {
+		return "", fieldError(id, ErrInvalidField)
+	}
+	if length < 10 {
+		return id + "0" + strconv.Itoa(length) + value, nil
+	}
+	return id + strconv.Itoa(length) + value, nil
+}
+
+// formatAmount é a função que formata um valor em centavos no formato do BR Code (exemplo: 1050 para 10.50).
+func formatAmount(cents int64) string {
+	amount := strconv.FormatInt(cents, 10)
+	for len(amount) < 3 {
+		amount = "0" + amount
+	}
+	return amount[:len(amount)-2] + "." + amount[len(amount)-2:]
+}
+
+// truncate é a função que corta o texto no número máximo de caracteres informado.
+func truncate(text string, max int) string {
+	if utf8.RuneCountInString(text) <= max {
+		return text
+	}
+	return string([]rune(text)[:max])
+}
+
+// isNumeric é a função que indica se o texto possui somente números.
+func isNumeric(text string) bool {
+	for _, char := range text {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// isAlphanumeric é a função que indica se o texto possui somente letras (sem acento) e números.
+func isAlphanumeric(text string) bool {
+	for _, char := range text {
+		if !(char >= '0' && char <= '9') && !(char >= 'a' && char <= 'z') && !(char >= 'A' && char <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
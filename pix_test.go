@@ -0,0 +1,104 @@
+package mercadopago
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"image/png"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"testing/iotest"
+)
+
+// Testando a montagem da cobrança Pix a partir do pagamento retornado pelo MercadoPago
+func TestPixCharge(t *testing.T) {
+
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "payments", "pix.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var payment PaymentConsultResponse
+	if err := json.Unmarshal(data, &payment); err != nil {
+		t.Fatal(err)
+	}
+
+	pixCharge, err := newPixCharge(payment)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if pixCharge.ID != 1241420908 || pixCharge.Status != StatusPending || pixCharge.TicketURL == "" || pixCharge.DateOfExpiration == nil {
+		t.Error("Cobrança Pix montada incorretamente!")
+	}
+
+	if _, err := png.Decode(bytes.NewReader(pixCharge.QrCodePNG)); err != nil {
+		t.Error("Imagem do QRCode retornada pelo MercadoPago decodada incorretamente!")
+	}
+
+	image, err := pixCharge.QRCodeImage(500)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded, err := png.Decode(bytes.NewReader(image))
+	if err != nil || decoded.Bounds().Dx() < 450 || decoded.Bounds().Dx() > 500 {
+		t.Error("Imagem do QRCode gerada incorretamente!")
+	}
+
+	brCode, err := pixCharge.BRCode()
+	if err != nil || brCode.MerchantName != "Fulano de Tal" || brCode.Key == "" {
+		t.Error("Chave Pix Copia-e-Cola lida incorretamente!")
+	}
+
+	if _, err := PixQRCode("", 0); err != ErrEmptyPixCode {
+		t.Error("Era esperado erro de chave Pix vazia!")
+	}
+
+}
+
+// Testando a validação local da cobrança Pix
+func TestPixPaymentRequestValidate(t *testing.T) {
+
+	pixPaymentRequest := PixPaymentRequest{
+		TransactionAmount: MustParseMoney("49.90"),
+		Payer: PaymentPayer{
+			Email:          "eduardo@email.com",
+			Identification: PayerIdentification{Type: "CPF", Number: "191.191.191-00"},
+		},
+	}
+
+	if err := pixPaymentRequest.Validate(); err != nil {
+		t.Error("Cobrança Pix válida retornou erro: " + err.Error())
+	}
+
+	pixPaymentRequest.TransactionAmount = NewMoney(0)
+	pixPaymentRequest.Payer.Email = "eduardo"
+	pixPaymentRequest.Payer.Identification.Number = "191.191.191-01"
+
+	validationErrors, ok := pixPaymentRequest.Validate().(ValidationErrors)
+	if !ok || len(validationErrors) != 3 {
+		t.Fatal("Era esperado 3 erros de validação!")
+	}
+
+	if len(validationErrors.Field("payer.email")) != 1 || len(validationErrors.Field("transaction_amount")) != 1 || len(validationErrors.Field("payer.identification.number")) != 1 {
+		t.Error(validationErrors)
+	}
+
+	key, err := newIdempotencyKey()
+	if otherKey, _ := newIdempotencyKey(); err != nil || len(key) != 36 || key == otherKey || key[14] != '4' {
+		t.Error("Chave de idempotência gerada incorretamente: " + key)
+	}
+
+	errEntropy := errors.New("entropia indisponível")
+	randReader = iotest.ErrReader(errEntropy)
+	defer func() { randReader = rand.Reader }()
+
+	pixPaymentRequest = PixPaymentRequest{TransactionAmount: NewMoney(10), Payer: PaymentPayer{Email: "comprador@email.com"}}
+	if charge, _, err := CreatePixPayment(pixPaymentRequest); !errors.Is(err, errEntropy) || charge != nil {
+		t.Error("Era esperado erro ao gerar a chave de idempotência!")
+	}
+
+}
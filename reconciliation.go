@@ -0,0 +1,291 @@
+package mercadopago
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ReconciliationPageSize é a quantidade de pagamentos buscados por página na conciliação.
+const ReconciliationPageSize = 100
+
+// ErrEmptyExternalReference é o erro informado na conciliação de um registro local sem referência externa, que não é buscado no MercadoPago.
+var ErrEmptyExternalReference = errors.New("mercadopago: registro da conciliação sem referência externa")
+
+// ReconciliationStatus é o tipo que representa o resultado da conciliação de um registro local com os pagamentos do MercadoPago.
+type ReconciliationStatus string
+
+// Resultados da conciliação de um registro local
+const (
+	ReconciliationMatched            ReconciliationStatus = "matched"             // Pagamento encontrado com o status e o valor esperados
+	ReconciliationAmountMismatch     ReconciliationStatus = "amount_mismatch"     // Pagamento encontrado com o status esperado porém com valor diferente
+	ReconciliationStatusMismatch     ReconciliationStatus = "status_mismatch"     // Pagamento encontrado porém com status diferente do esperado (exemplo: ainda pendente)
+	ReconciliationMissing            ReconciliationStatus = "missing"             // Nenhum pagamento encontrado com a referência externa
+	ReconciliationDuplicate          ReconciliationStatus = "duplicate"           // Mais de um pagamento aprovado (ou reembolsado/estornado) com a mesma referência externa
+	ReconciliationUnexpectedReversal ReconciliationStatus = "unexpected_reversal" // Pagamento reembolsado, estornado ou em disputa sem que isso fosse esperado
+	ReconciliationError              ReconciliationStatus = "error"               // Erro ao buscar os pagamentos da referência externa
+)
+
+// ReconciliationRecord é a struct que contém as informações de um registro local (pedido) que deve ser conciliado com os pagamentos do MercadoPago.
+type ReconciliationRecord struct {
+	ExternalReference string        // Referência externa enviada na criação do pagamento (nosso ID de controle interno)
+	ExpectedAmount    Money         // Valor esperado do pagamento
+	ExpectedStatus    PaymentStatus // Status esperado do pagamento (padrão approved)
+}
+
+// ReconciliationSource é a interface que fornece os registros locais para a conciliação, permitindo ler os registros de um banco de dados ou arquivo sem carregar tudo em memória.
+// O método Next deve retornar io.EOF quando não existirem mais registros.
+type ReconciliationSource interface {
+	Next() (ReconciliationRecord, error)
+}
+
+// ReconciliationRecords é a função que retorna um ReconciliationSource que percorre uma lista de registros.
+func ReconciliationRecords(records ...ReconciliationRecord) ReconciliationSource {
+	return &reconciliationRecords{records: records}
+}
+
+// reconciliationRecords é a struct que implementa o ReconciliationSource sobre uma lista de registros.
+type reconciliationRecords struct {
+	records []ReconciliationRecord
+	index   int
+}
+
+// Next é o método que retorna o próximo registro da lista, ou io.EOF no final da lista.
+func (reconciliationRecords *reconciliationRecords) Next() (ReconciliationRecord, error) {
+	if reconciliationRecords.index >= len(reconciliationRecords.records) {
+		return ReconciliationRecord{}, io.EOF
+	}
+	reconciliationRecords.index++
+	return reconciliationRecords.records[reconciliationRecords.index-1], nil
+}
+
+// ReconciliationEntry é a struct que contém o resultado da conciliação de um registro local.
+type ReconciliationEntry struct {
+	ExternalReference string               `json:"external_reference"` // Referência externa do registro local
+	Status            ReconciliationStatus `json:"status"`             // Resultado da conciliação
+	ExpectedAmount    Money                `json:"expected_amount"`    // Valor esperado do pagamento
+	ExpectedStatus    PaymentStatus        `json:"expected_status"`    // Status esperado do pagamento
+	PaymentIDs        []int                `json:"payment_ids"`        // IDs dos pagamentos considerados (todos os pagamentos duplicados no caso de duplicidade)
+	PaymentStatus     PaymentStatus        `json:"payment_status"`     // Status do pagamento encontrado
+	PaidAmount        Money                `json:"paid_amount"`        // Valor do pagamento encontrado
+	RefundedAmount    Money                `json:"refunded_amount"`    // Valor reembolsado do pagamento encontrado
+	Message           string               `json:"message"`            // Descrição do resultado
+	Err               error                `json:"-"`                  // Erro ao buscar os pagamentos (somente no resultado error)
+	ErrorResponse     *ErrorResponse       `json:"-"`                  // Erro retornado pelo MercadoPago ao buscar os pagamentos (somente no resultado error)
+}
+
+// ReconciliationReport é a struct que contém o resultado da conciliação de todos os registros locais, na mesma ordem dos registros.
+type ReconciliationReport struct {
+	Entries []ReconciliationEntry
+}
+
+// Summary é o método que retorna a quantidade de registros de cada resultado da conciliação.
+func (reconciliationReport ReconciliationReport) Summary() map[ReconciliationStatus]int {
+	summary := map[ReconciliationStatus]int{}
+	for _, entry := range reconciliationReport.Entries {
+		summary[entry.Status]++
+	}
+	return summary
+}
+
+// Filter é o método que retorna os registros com algum dos resultados informados (exemplo: somente os registros com divergência).
+func (reconciliationReport ReconciliationReport) Filter(statuses ...ReconciliationStatus) []ReconciliationEntry {
+	var entries []ReconciliationEntry
+	for _, entry := range reconciliationReport.Entries {
+		for _, status := range statuses {
+			if entry.Status == status {
+				entries = append(entries, entry)
+				break
+			}
+		}
+	}
+	return entries
+}
+
+// WriteCSV é o método que escreve o relatório da conciliação em CSV, com uma linha de cabeçalho e uma linha por registro.
+// Os IDs dos pagamentos são separados por |.
+func (reconciliationReport ReconciliationReport) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"external_reference", "status", "expected_amount", "expected_status", "payment_ids", "payment_status", "paid_amount", "refunded_amount", "message"})
+
+	for _, entry := range reconciliationReport.Entries {
+		paymentIDs := make([]string, len(entry.PaymentIDs))
+		for i, paymentID := range entry.PaymentIDs {
+			paymentIDs[i] = strconv.Itoa(paymentID)
+		}
+
+		writer.Write([]string{
+			entry.ExternalReference,
+			string(entry.Status),
+			entry.ExpectedAmount.String(),
+			string(entry.ExpectedStatus),
+			strings.Join(paymentIDs, "|"),
+			string(entry.PaymentStatus),
+			entry.PaidAmount.String(),
+			entry.RefundedAmount.String(),
+			entry.Message,
+		})
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteJSON é o método que escreve o relatório da conciliação em JSON, com o resumo de cada resultado e todos os registros.
+func (reconciliationReport ReconciliationReport) WriteJSON(w io.Writer) error {
+	entries := reconciliationReport.Entries
+	if entries == nil {
+		entries = []ReconciliationEntry{}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(struct {
+		Summary map[ReconciliationStatus]int `json:"summary"`
+		Entries []ReconciliationEntry        `json:"entries"`
+	}{reconciliationReport.Summary(), entries})
+}
+
+// Reconcile é a função que concilia os registros locais com os pagamentos do MercadoPago, buscando os pagamentos de cada referência externa
+// pelo SearchConsultPayments. Para cada registro é informado se o pagamento foi encontrado com o status e o valor esperados, se o valor ou o status
+// divergem, se nenhum pagamento foi encontrado, se existe mais de um pagamento aprovado para a mesma referência e se o pagamento foi reembolsado,
+// estornado ou contestado sem que isso fosse esperado. As tentativas rejeitadas ou canceladas são ignoradas quando existe outro pagamento na referência.
+//
+// Erros ao buscar os pagamentos de uma referência não interrompem a conciliação, eles são informados no registro com o resultado error.
+// Registros com a referência externa em branco não são buscados (a busca sem o filtro retornaria os pagamentos de todas as referências) e também são informados com o resultado error.
+// Somente erros ao ler os registros locais interrompem a conciliação e são retornados junto com o relatório parcial.
+func Reconcile(source ReconciliationSource, mercadoPagoAccessToken ...string) (*ReconciliationReport, error) {
+	report := &ReconciliationReport{}
+
+	for {
+		record, err := source.Next()
+		if err == io.EOF {
+			return report, nil
+		}
+		if err != nil {
+			return report, err
+		}
+
+		if record.ExpectedStatus == "" {
+			record.ExpectedStatus = StatusApproved
+		}
+
+		payments, errorResponse, err := searchPaymentsByReference(record.ExternalReference, mercadoPagoAccessToken...)
+		if err != nil || errorResponse != nil {
+			entry := ReconciliationEntry{
+				ExternalReference: record.ExternalReference,
+				Status:            ReconciliationError,
+				ExpectedAmount:    record.ExpectedAmount,
+				ExpectedStatus:    record.ExpectedStatus,
+				Err:               err,
+				ErrorResponse:     errorResponse,
+			}
+			if err != nil {
+				entry.Message = err.Error()
+			} else {
+				entry.Message = errorResponse.Message
+			}
+			report.Entries = append(report.Entries, entry)
+			continue
+		}
+
+		report.Entries = append(report.Entries, ReconcilePayments(record, payments))
+	}
+}
+
+// ReconcilePayments é a função que concilia um registro local com os pagamentos da sua referência externa já buscados, seguindo as mesmas regras do Reconcile.
+func ReconcilePayments(record ReconciliationRecord, payments []PaymentConsultResponse) ReconciliationEntry {
+	if record.ExpectedStatus == "" {
+		record.ExpectedStatus = StatusApproved
+	}
+
+	entry := ReconciliationEntry{
+		ExternalReference: record.ExternalReference,
+		ExpectedAmount:    record.ExpectedAmount,
+		ExpectedStatus:    record.ExpectedStatus,
+	}
+
+	if len(payments) == 0 {
+		entry.Status, entry.Message = ReconciliationMissing, "nenhum pagamento encontrado com a referência externa"
+		return entry
+	}
+
+	// Pagamentos em que o dinheiro chegou a ser recebido (mesmo que depois tenha sido devolvido)
+	var captured []PaymentConsultResponse
+	for _, payment := range payments {
+		if payment.Status == StatusApproved || payment.Status == StatusInMediation || payment.Status.IsReversed() {
+			captured = append(captured, payment)
+		}
+	}
+
+	if len(captured) > 1 {
+		entry.Status, entry.Message = ReconciliationDuplicate, strconv.Itoa(len(captured))+" pagamentos recebidos com a mesma referência externa"
+		for _, payment := range captured {
+			entry.PaymentIDs = append(entry.PaymentIDs, payment.ID)
+			entry.PaidAmount = entry.PaidAmount.Add(payment.TransactionAmount)
+			entry.RefundedAmount = entry.RefundedAmount.Add(payment.TransactionAmountRefunded)
+		}
+		return entry
+	}
+
+	// Quando nenhum pagamento foi recebido consideramos a tentativa mais recente (exemplo: um Pix pendente após um cartão rejeitado)
+	payment := payments[0]
+	if len(captured) == 1 {
+		payment = captured[0]
+	} else {
+		for _, other := range payments[1:] {
+			if other.DateCreated.After(payment.DateCreated.Time) {
+				payment = other
+			}
+		}
+	}
+
+	entry.PaymentIDs = []int{payment.ID}
+	entry.PaymentStatus = payment.Status
+	entry.PaidAmount = payment.TransactionAmount
+	entry.RefundedAmount = payment.TransactionAmountRefunded
+
+	expectedReversal := record.ExpectedStatus.IsReversed() || record.ExpectedStatus == StatusInMediation
+	reversed := payment.Status.IsReversed() || payment.Status == StatusInMediation || payment.TransactionAmountRefunded.IsPositive()
+
+	switch {
+	case reversed && !expectedReversal:
+		entry.Status, entry.Message = ReconciliationUnexpectedReversal, "pagamento "+string(payment.Status)+" com "+payment.TransactionAmountRefunded.String()+" reembolsado"
+	case payment.Status != record.ExpectedStatus:
+		entry.Status, entry.Message = ReconciliationStatusMismatch, "status esperado "+string(record.ExpectedStatus)+", encontrado "+string(payment.Status)
+	case !payment.TransactionAmount.Equal(record.ExpectedAmount):
+		entry.Status, entry.Message = ReconciliationAmountMismatch, "valor esperado "+record.ExpectedAmount.String()+", encontrado "+payment.TransactionAmount.String()
+	default:
+		entry.Status = ReconciliationMatched
+	}
+
+	return entry
+}
+
+// searchPaymentsByReference é a função que busca todos os pagamentos de uma referência externa, percorrendo todas as páginas da busca.
+func searchPaymentsByReference(externalReference string, mercadoPagoAccessToken ...string) ([]PaymentConsultResponse, *ErrorResponse, error) {
+	if strings.TrimSpace(externalReference) == "" {
+		return nil, nil, ErrEmptyExternalReference
+	}
+
+	var payments []PaymentConsultResponse
+
+	for {
+		response, errorResponse, err := SearchConsultPayments(PaymentConsultSearchParams{
+			"external_reference": externalReference,
+			"offset":             len(payments),
+			"limit":              ReconciliationPageSize,
+		}, mercadoPagoAccessToken...)
+		if err != nil || errorResponse != nil {
+			return nil, errorResponse, err
+		}
+
+		payments = append(payments, response.Results...)
+		if len(response.Results) == 0 || len(payments) >= response.Paging.Total {
+			return payments, nil, nil
+		}
+	}
+}
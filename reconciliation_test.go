@@ -0,0 +1,183 @@
+package mercadopago
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/eduardo-mior/mercadopago-sdk-go/mercadopagotest"
+)
+
+// Testando a busca dos pagamentos feitos pelos pagadores
+func TestSuccessOnSearchConsultPayments(t *testing.T) {
+
+	response, mercadopagoErr, err := SearchConsultPayments(PaymentConsultSearchParams{
+		"external_reference": "test-00001",
+	})
+
+	if err != nil {
+		t.Error("Erro inesperado!")
+		t.Error(err.Error())
+
+	} else if mercadopagoErr != nil {
+		t.Error("Erro não tratado MercadoPago!")
+		t.Error(mercadopagoErr.Message)
+
+	} else if response.Paging.Total == 0 || len(response.Results) == 0 || response.Results[0].ExternalReference != "test-00001" {
+		t.Error("Pagamentos buscados incorretamente!")
+	}
+
+}
+
+// Testando a conciliação dos pedidos com os pagamentos de um servidor de testes separado, assim os pagamentos não se acumulam entre as execuções
+func TestSuccessOnReconcile(t *testing.T) {
+
+	server := mercadopagotest.NewServer()
+	defer server.Close()
+	defer server.Setenv()()
+
+	payments := []map[string]interface{}{
+		{"external_reference": "reconcile-matched", "transaction_amount": 100},
+		{"external_reference": "reconcile-amount", "transaction_amount": 90},
+		{"external_reference": "reconcile-duplicate", "transaction_amount": 50},
+		{"external_reference": "reconcile-duplicate", "transaction_amount": 50},
+		{"external_reference": "reconcile-refunded", "transaction_amount": 70, "status": "refunded", "transaction_amount_refunded": 70},
+		{"external_reference": "reconcile-pending", "transaction_amount": 30, "status": "pending", "status_detail": "pending_waiting_transfer"},
+		{"external_reference": "reconcile-retry", "transaction_amount": 40, "status": "rejected", "status_detail": "cc_rejected_other_reason"},
+		{"external_reference": "reconcile-retry", "transaction_amount": 40},
+	}
+	for _, payment := range payments {
+		if _, err := server.AddPayment(payment); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	report, err := Reconcile(ReconciliationRecords(
+		ReconciliationRecord{ExternalReference: "reconcile-matched", ExpectedAmount: NewMoney(100)},
+		ReconciliationRecord{ExternalReference: "reconcile-amount", ExpectedAmount: NewMoney(100)},
+		ReconciliationRecord{ExternalReference: "reconcile-missing", ExpectedAmount: NewMoney(10)},
+		ReconciliationRecord{ExternalReference: "reconcile-duplicate", ExpectedAmount: NewMoney(50)},
+		ReconciliationRecord{ExternalReference: "reconcile-refunded", ExpectedAmount: NewMoney(70)},
+		ReconciliationRecord{ExternalReference: "reconcile-pending", ExpectedAmount: NewMoney(30)},
+		ReconciliationRecord{ExternalReference: "reconcile-retry", ExpectedAmount: NewMoney(40)},
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []ReconciliationStatus{
+		ReconciliationMatched,
+		ReconciliationAmountMismatch,
+		ReconciliationMissing,
+		ReconciliationDuplicate,
+		ReconciliationUnexpectedReversal,
+		ReconciliationStatusMismatch,
+		ReconciliationMatched,
+	}
+	if len(report.Entries) != len(expected) {
+		t.Fatal("Quantidade de registros conciliados incorreta!")
+	}
+	for i, status := range expected {
+		if report.Entries[i].Status != status {
+			t.Error("Registro " + report.Entries[i].ExternalReference + " conciliado incorretamente: " + string(report.Entries[i].Status))
+		}
+	}
+
+	if duplicate := report.Entries[3]; len(duplicate.PaymentIDs) != 2 || !duplicate.PaidAmount.Equal(NewMoney(100)) {
+		t.Error("Pagamentos duplicados informados incorretamente!")
+	}
+
+	summary := report.Summary()
+	if summary[ReconciliationMatched] != 2 || summary[ReconciliationMissing] != 1 || len(report.Filter(ReconciliationDuplicate, ReconciliationMissing)) != 2 {
+		t.Error("Resumo da conciliação incorreto!")
+	}
+
+}
+
+// Testando a conciliação quando o MercadoPago retorna erro
+func TestErrorOnReconcile(t *testing.T) {
+
+	testServer.FailNext("GET", "/v1/payments/search", 500)
+	defer testServer.ClearFailures()
+
+	report, err := Reconcile(ReconciliationRecords(
+		ReconciliationRecord{ExternalReference: "reconcile-error", ExpectedAmount: NewMoney(10)},
+		ReconciliationRecord{ExternalReference: "reconcile-error", ExpectedAmount: NewMoney(10)},
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.Entries[0].Status != ReconciliationError || report.Entries[0].ErrorResponse == nil || report.Entries[1].Status != ReconciliationMissing {
+		t.Error("Erro da conciliação tratado incorretamente!")
+	}
+
+}
+
+// Testando a conciliação de registros sem referência externa, que não devem ser buscados no MercadoPago
+func TestEmptyExternalReferenceOnReconcile(t *testing.T) {
+
+	server := mercadopagotest.NewServer()
+	defer server.Close()
+	defer server.Setenv()()
+
+	server.AddPayment(map[string]interface{}{"external_reference": "", "transaction_amount": 10})
+
+	report, err := Reconcile(ReconciliationRecords(
+		ReconciliationRecord{ExternalReference: "", ExpectedAmount: NewMoney(10)},
+		ReconciliationRecord{ExternalReference: "   ", ExpectedAmount: NewMoney(10)},
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, entry := range report.Entries {
+		if entry.Status != ReconciliationError || !errors.Is(entry.Err, ErrEmptyExternalReference) {
+			t.Error("Registro sem referência externa conciliado incorretamente: " + string(entry.Status))
+		}
+	}
+	if len(server.Requests()) != 0 {
+		t.Error("Registro sem referência externa buscado no MercadoPago!")
+	}
+
+}
+
+// Testando a escrita do relatório da conciliação em CSV e JSON
+func TestReconciliationReportWrite(t *testing.T) {
+
+	report := ReconciliationReport{Entries: []ReconciliationEntry{
+		ReconcilePayments(ReconciliationRecord{ExternalReference: "pedido-1", ExpectedAmount: NewMoney(10)}, []PaymentConsultResponse{
+			{ID: 1, Status: StatusApproved, TransactionAmount: NewMoney(10)},
+			{ID: 2, Status: StatusApproved, TransactionAmount: NewMoney(10)},
+		}),
+		ReconcilePayments(ReconciliationRecord{ExternalReference: "pedido-2", ExpectedAmount: NewMoney(10)}, nil),
+	}}
+
+	var buffer bytes.Buffer
+	if err := report.WriteCSV(&buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := csv.NewReader(&buffer).ReadAll()
+	if err != nil || len(rows) != 3 || rows[1][1] != "duplicate" || rows[1][4] != "1|2" || rows[1][6] != "20" || rows[2][1] != "missing" {
+		t.Error("Relatório CSV gerado incorretamente!")
+		t.Error(rows)
+	}
+
+	buffer.Reset()
+	if err := report.WriteJSON(&buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded struct {
+		Summary map[string]int           `json:"summary"`
+		Entries []map[string]interface{} `json:"entries"`
+	}
+	if err := json.Unmarshal(buffer.Bytes(), &decoded); err != nil || decoded.Summary["duplicate"] != 1 || len(decoded.Entries) != 2 || decoded.Entries[1]["external_reference"] != "pedido-2" {
+		t.Error("Relatório JSON gerado incorretamente!")
+		t.Error(buffer.String())
+	}
+
+}
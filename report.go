@@ -0,0 +1,228 @@
+package mercadopago
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ReportType é o tipo que representa os relatórios financeiros do MercadoPago.
+type ReportType string
+
+// Relatórios financeiros do MercadoPago
+const (
+	ReportSettlement ReportType = "settlement_report" // Relatório de liquidações (todas as transações, tarifas e valores liquidados)
+	ReportRelease    ReportType = "release_report"    // Relatório de liberações (dinheiro liberado, saques e saldo disponível)
+)
+
+// ReportRow é a struct que contém uma linha dos relatórios de liquidações e de liberações, com as colunas mais usadas já convertidas.
+// As colunas que não existem no relatório ficam zeradas, todas as colunas (inclusive as não convertidas) ficam disponíveis em Values.
+type ReportRow struct {
+	Line                int               // Número do registro no arquivo, sem contar as linhas em branco (o cabeçalho é o registro 1)
+	SourceID            string            // SOURCE_ID, ID da transação no MercadoPago (exemplo: ID do pagamento)
+	ExternalReference   string            // EXTERNAL_REFERENCE, nosso ID de controle interno
+	TransactionType     string            // TRANSACTION_TYPE (settlement_report), tipo da transação (SETTLEMENT, REFUND, CHARGEBACK...)
+	RecordType          string            // RECORD_TYPE (release_report), tipo do registro (release, initial_available_balance, total...)
+	Description         string            // DESCRIPTION, descrição da transação (payment, refund, withdrawal...)
+	PaymentMethod       string            // PAYMENT_METHOD, método de pagamento (pix, master, bolbradesco...)
+	PaymentMethodType   string            // PAYMENT_METHOD_TYPE, tipo do método de pagamento (credit_card, ticket...)
+	Currency            string            // TRANSACTION_CURRENCY, SETTLEMENT_CURRENCY ou CURRENCY, moeda da transação
+	Installments        int               // INSTALLMENTS, número de parcelas
+	TransactionAmount   Money             // TRANSACTION_AMOUNT, valor bruto da transação
+	FeeAmount           Money             // FEE_AMOUNT ou MP_FEE_AMOUNT, tarifa cobrada pelo MercadoPago (negativa)
+	SettlementNetAmount Money             // SETTLEMENT_NET_AMOUNT, valor líquido liquidado
+	GrossAmount         Money             // GROSS_AMOUNT, valor bruto liberado
+	NetCreditAmount     Money             // NET_CREDIT_AMOUNT, valor líquido creditado
+	NetDebitAmount      Money             // NET_DEBIT_AMOUNT, valor líquido debitado
+	Date                *Time             // DATE (release_report), data da liberação
+	TransactionDate     *Time             // TRANSACTION_DATE, data da transação
+	SettlementDate      *Time             // SETTLEMENT_DATE, data da liquidação
+	MoneyReleaseDate    *Time             // MONEY_RELEASE_DATE, data em que o dinheiro é liberado
+	Values              map[string]string // Valores de todas as colunas da linha, pelo nome da coluna
+}
+
+// Get é o método que retorna o valor de qualquer coluna da linha, ou uma string vazia quando a coluna não existe no relatório.
+func (reportRow ReportRow) Get(column string) string {
+	return reportRow.Values[column]
+}
+
+// ReportReader é a struct que lê os relatórios em CSV do MercadoPago linha por linha, sem carregar o arquivo inteiro em memória.
+// O separador das colunas (, ou ;) é detectado automaticamente pelo cabeçalho do arquivo.
+type ReportReader struct {
+	Columns []string // Colunas do relatório, na ordem do cabeçalho
+
+	reader *csv.Reader
+	closer io.Closer
+	line   int
+}
+
+// NewReportReader é a função que cria um ReportReader a partir de um relatório em CSV (exemplo: um arquivo já baixado), lendo o cabeçalho do relatório.
+// Caso o io.Reader também seja um io.Closer ele é fechado pelo método Close.
+func NewReportReader(r io.Reader) (*ReportReader, error) {
+	buffered := bufio.NewReader(r)
+
+	// O cabeçalho é usado para detectar o separador, o Peek não consome os bytes lidos.
+	// Os bytes são lidos somente até o fim da primeira linha, para não travar esperando o resto do arquivo quando ele é baixado aos poucos.
+	_, err := buffered.Peek(1)
+	header, _ := buffered.Peek(buffered.Buffered())
+	for err == nil && bytes.IndexByte(header, '\n') < 0 && len(header) < 4096 {
+		_, err = buffered.Peek(len(header) + 1)
+		header, _ = buffered.Peek(buffered.Buffered())
+	}
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if i := bytes.IndexByte(header, '\n'); i >= 0 {
+		header = header[:i]
+	}
+
+	// Removendo o BOM do UTF-8 que é adicionado nos relatórios abertos no Excel
+	if bytes.HasPrefix(header, []byte("\xef\xbb\xbf")) {
+		buffered.Discard(3)
+	}
+
+	reportReader := &ReportReader{reader: csv.NewReader(buffered)}
+	if closer, ok := r.(io.Closer); ok {
+		reportReader.closer = closer
+	}
+	if bytes.Count(header, []byte(";")) > bytes.Count(header, []byte(",")) {
+		reportReader.reader.Comma = ';'
+	}
+	reportReader.reader.FieldsPerRecord = -1
+	reportReader.reader.LazyQuotes = true
+
+	columns, err := reportReader.reader.Read()
+	if err == io.EOF {
+		return reportReader, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	reportReader.line = 1
+	for _, column := range columns {
+		reportReader.Columns = append(reportReader.Columns, strings.ToUpper(strings.TrimSpace(column)))
+	}
+	return reportReader, nil
+}
+
+// Next é o método que lê e converte a próxima linha do relatório, retornando io.EOF no final do relatório.
+// As linhas em branco são ignoradas e valores inválidos nas colunas convertidas retornam um erro com o número do registro e o nome da coluna.
+func (reportReader *ReportReader) Next() (*ReportRow, error) {
+	for {
+		record, err := reportReader.reader.Read()
+		if err != nil {
+			return nil, err
+		}
+		reportReader.line++
+
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+
+		values := make(map[string]string, len(reportReader.Columns))
+		for i, column := range reportReader.Columns {
+			if i < len(record) {
+				values[column] = strings.TrimSpace(record[i])
+			}
+		}
+
+		return newReportRow(reportReader.line, values)
+	}
+}
+
+// Close é o método que fecha o relatório, deve ser chamado sempre que o relatório for baixado pelo DownloadReport.
+func (reportReader *ReportReader) Close() error {
+	if reportReader.closer == nil {
+		return nil
+	}
+	return reportReader.closer.Close()
+}
+
+// newReportRow é a função que converte os valores das colunas de uma linha do relatório em ReportRow.
+func newReportRow(line int, values map[string]string) (*ReportRow, error) {
+	reportRow := &ReportRow{
+		Line:              line,
+		SourceID:          values["SOURCE_ID"],
+		ExternalReference: values["EXTERNAL_REFERENCE"],
+		TransactionType:   values["TRANSACTION_TYPE"],
+		RecordType:        values["RECORD_TYPE"],
+		Description:       values["DESCRIPTION"],
+		PaymentMethod:     values["PAYMENT_METHOD"],
+		PaymentMethodType: values["PAYMENT_METHOD_TYPE"],
+		Currency:          firstValue(values, "TRANSACTION_CURRENCY", "SETTLEMENT_CURRENCY", "CURRENCY"),
+		Values:            values,
+	}
+
+	if installments := values["INSTALLMENTS"]; installments != "" {
+		number, err := strconv.Atoi(installments)
+		if err != nil {
+			return nil, reportError(line, "INSTALLMENTS", err)
+		}
+		reportRow.Installments = number
+	}
+
+	amounts := []struct {
+		column string
+		money  *Money
+	}{
+		{"TRANSACTION_AMOUNT", &reportRow.TransactionAmount},
+		{"FEE_AMOUNT", &reportRow.FeeAmount},
+		{"MP_FEE_AMOUNT", &reportRow.FeeAmount},
+		{"SETTLEMENT_NET_AMOUNT", &reportRow.SettlementNetAmount},
+		{"GROSS_AMOUNT", &reportRow.GrossAmount},
+		{"NET_CREDIT_AMOUNT", &reportRow.NetCreditAmount},
+		{"NET_DEBIT_AMOUNT", &reportRow.NetDebitAmount},
+	}
+	for _, amount := range amounts {
+		if values[amount.column] == "" {
+			continue
+		}
+		money, err := ParseMoney(values[amount.column])
+		if err != nil {
+			return nil, reportError(line, amount.column, err)
+		}
+		*amount.money = money
+	}
+
+	dates := []struct {
+		column string
+		time   **Time
+	}{
+		{"DATE", &reportRow.Date},
+		{"TRANSACTION_DATE", &reportRow.TransactionDate},
+		{"SETTLEMENT_DATE", &reportRow.SettlementDate},
+		{"MONEY_RELEASE_DATE", &reportRow.MoneyReleaseDate},
+	}
+	for _, date := range dates {
+		if values[date.column] == "" {
+			continue
+		}
+		parsed, err := ParseTime(values[date.column])
+		if err != nil {
+			return nil, reportError(line, date.column, err)
+		}
+		*date.time = &parsed
+	}
+
+	return reportRow, nil
+}
+
+// firstValue é a função que retorna o valor da primeira coluna preenchida.
+func firstValue(values map[string]string, columns ...string) string {
+	for _, column := range columns {
+		if values[column] != "" {
+			return values[column]
+		}
+	}
+	return ""
+}
+
+// reportError é a função que monta o erro de uma coluna inválida do relatório.
+func reportError(line int, column string, err error) error {
+	return fmt.Errorf("mercadopago: relatório linha %d, coluna %s: %w", line, column, err)
+}
@@ -0,0 +1,207 @@
+package mercadopago
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eduardo-mior/mercadopago-sdk-go/mercadopagotest"
+)
+
+// Testando a leitura de um relatório de liberações separado por ; e salvo com BOM
+func TestReportReader(t *testing.T) {
+
+	report := "\xef\xbb\xbfDATE;SOURCE_ID;EXTERNAL_REFERENCE;RECORD_TYPE;DESCRIPTION;NET_CREDIT_AMOUNT;NET_DEBIT_AMOUNT;GROSS_AMOUNT;MP_FEE_AMOUNT;INSTALLMENTS;PAYMENT_METHOD;CURRENCY\n" +
+		"2022-01-25T12:30:00.000-04:00;1241420907;test-00001;release;payment;48.01;0.00;50.00;-1.99;1;master;BRL\n" +
+		"\n" +
+		"2022-01-26T08:00:00.000-04:00;1241420907;test-00001;release;refund;0.00;50.00;-50.00;0.00;1;master;BRL\n"
+
+	reportReader, err := NewReportReader(ioutil.NopCloser(strings.NewReader(report)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reportReader.Close()
+
+	if len(reportReader.Columns) != 12 || reportReader.Columns[0] != "DATE" {
+		t.Error("Cabeçalho do relatório lido incorretamente!")
+		t.Error(reportReader.Columns)
+	}
+
+	row, err := reportReader.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if row.Line != 2 || row.SourceID != "1241420907" || row.ExternalReference != "test-00001" || row.Description != "payment" || row.Currency != "BRL" || row.Installments != 1 {
+		t.Error("Linha do relatório lida incorretamente!")
+	}
+	if !row.NetCreditAmount.Equal(MustParseMoney("48.01")) || !row.FeeAmount.Equal(MustParseMoney("-1.99")) || !row.GrossAmount.Equal(NewMoney(50)) || row.Date == nil || row.Date.Day() != 25 {
+		t.Error("Valores da linha do relatório convertidos incorretamente!")
+	}
+	if row.Get("PAYMENT_METHOD") != "master" || row.Get("POS_ID") != "" {
+		t.Error("Colunas da linha do relatório retornadas incorretamente!")
+	}
+
+	if row, err = reportReader.Next(); err != nil || row.Line != 3 || !row.NetDebitAmount.Equal(NewMoney(50)) {
+		t.Error("Linha em branco do relatório tratada incorretamente!")
+	}
+
+	if _, err = reportReader.Next(); err != io.EOF {
+		t.Error("Final do relatório tratado incorretamente!")
+	}
+
+}
+
+// Testando a leitura de um relatório com um valor inválido
+func TestErrorOnReportReader(t *testing.T) {
+
+	reportReader, err := NewReportReader(strings.NewReader("SOURCE_ID,TRANSACTION_AMOUNT\n1241420907,abc\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := reportReader.Next(); !errors.Is(err, ErrInvalidMoney) || !strings.Contains(err.Error(), "linha 2, coluna TRANSACTION_AMOUNT") {
+		t.Error("Erro do valor inválido retornado incorretamente!")
+		t.Error(err)
+	}
+
+}
+
+// Testando a configuração, a geração, a listagem e o download de um relatório de liquidações em um servidor de testes separado, assim as configurações não se acumulam entre as execuções
+func TestSuccessOnSettlementReport(t *testing.T) {
+
+	server := mercadopagotest.NewServer()
+	defer server.Close()
+	defer server.Setenv()()
+
+	showFeePrevision := false
+	reportConfig, mercadopagoErr, err := CreateReportConfig(ReportSettlement, ReportConfig{
+		Columns:          []ReportColumn{{Key: "SOURCE_ID"}, {Key: "EXTERNAL_REFERENCE"}, {Key: "TRANSACTION_TYPE"}, {Key: "TRANSACTION_AMOUNT"}, {Key: "FEE_AMOUNT"}, {Key: "SETTLEMENT_NET_AMOUNT"}},
+		Separator:        ";",
+		ShowFeePrevision: &showFeePrevision,
+	})
+	if err != nil || mercadopagoErr != nil || reportConfig.Separator != ";" || len(reportConfig.Columns) != 6 {
+		t.Fatal("Erro ao criar as configurações do relatório!")
+	}
+
+	reportConfig, mercadopagoErr, err = UpdateReportConfig(ReportSettlement, ReportConfig{NotificationEmailList: []string{"financeiro@email.com"}})
+	if err != nil || mercadopagoErr != nil || len(reportConfig.NotificationEmailList) != 1 || reportConfig.ShowFeePrevision == nil || *reportConfig.ShowFeePrevision {
+		t.Error("Erro ao atualizar as configurações do relatório!")
+	}
+
+	if reportConfig, _, _ = GetReportConfig(ReportSettlement); reportConfig == nil || reportConfig.Separator != ";" {
+		t.Error("Configurações do relatório retornadas incorretamente!")
+	}
+
+	server.AddPayment(map[string]interface{}{
+		"external_reference":          "report-00001",
+		"transaction_amount":          100,
+		"transaction_amount_refunded": 30,
+		"fee_details":                 []map[string]interface{}{{"type": "mercadopago_fee", "amount": 4.99, "fee_payer": "collector"}},
+	})
+
+	report, mercadopagoErr, err := CreateReport(ReportSettlement, time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	if err != nil || mercadopagoErr != nil || report.ID == 0 {
+		t.Fatal("Erro ao gerar o relatório!")
+	}
+
+	reports, mercadopagoErr, err := ListReports(ReportSettlement)
+	if err != nil || mercadopagoErr != nil || len(reports) == 0 || reports[len(reports)-1].FileName == "" {
+		t.Fatal("Relatórios listados incorretamente!")
+	}
+
+	reportReader, mercadopagoErr, err := DownloadReport(context.Background(), ReportSettlement, reports[len(reports)-1].FileName)
+	if err != nil || mercadopagoErr != nil {
+		t.Fatal("Erro ao baixar o relatório!")
+	}
+	defer reportReader.Close()
+
+	var rows []*ReportRow
+	for {
+		row, err := reportReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if row.ExternalReference == "report-00001" {
+			rows = append(rows, row)
+		}
+	}
+
+	if len(rows) != 2 || rows[0].TransactionType != "SETTLEMENT" || !rows[0].FeeAmount.Equal(MustParseMoney("-4.99")) || !rows[0].SettlementNetAmount.Equal(MustParseMoney("95.01")) {
+		t.Error("Linha do pagamento do relatório incorreta!")
+	}
+	if len(rows) == 2 && (rows[1].TransactionType != "REFUND" || !rows[1].TransactionAmount.Equal(NewMoney(-30))) {
+		t.Error("Linha do reembolso do relatório incorreta!")
+	}
+
+}
+
+// Testando o download de um relatório que não existe
+func TestErrorOnDownloadReport(t *testing.T) {
+
+	reportReader, mercadopagoErr, err := DownloadReport(context.Background(), ReportRelease, "release-report-inexistente.csv")
+	if err != nil || reportReader != nil || mercadopagoErr == nil || mercadopagoErr.Status != 404 {
+		t.Error("Erro do download tratado incorretamente!")
+	}
+
+}
+
+// Testando o cancelamento do download de um relatório travado
+func TestCancelDownloadReport(t *testing.T) {
+
+	stalled := make(chan struct{})
+	defer close(stalled)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/csv")
+		w.Write([]byte("DATE,SOURCE_ID\n2026-01-01,1\n"))
+		w.(http.Flusher).Flush()
+		select {
+		case <-stalled:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+
+	baseURL := os.Getenv("MERCADO_PAGO_BASE_URL")
+	os.Setenv("MERCADO_PAGO_BASE_URL", server.URL)
+	defer os.Setenv("MERCADO_PAGO_BASE_URL", baseURL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	reportReader, mercadopagoErr, err := DownloadReport(ctx, ReportSettlement, "settlement-report.csv")
+	if err != nil || mercadopagoErr != nil {
+		t.Fatal("Erro ao baixar o relatório!")
+	}
+	defer reportReader.Close()
+
+	if _, err := reportReader.Next(); err != nil {
+		t.Fatal(err)
+	}
+
+	finished := make(chan error, 1)
+	go func() {
+		_, err := reportReader.Next()
+		finished <- err
+	}()
+
+	select {
+	case err := <-finished:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Error("Era esperado o erro do cancelamento do download!")
+			t.Error(err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Error("O download não foi cancelado!")
+	}
+
+}
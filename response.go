@@ -0,0 +1,79 @@
+package mercadopago
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/eduardo-mior/mercadopago-sdk-go/internal/request"
+)
+
+var (
+	onResponseMutex sync.RWMutex
+	onResponse      func(ResponseMetadata)
+)
+
+// SetOnResponse é a função que configura uma função opcional que é chamada com os metadados de todas as respostas recebidas do MercadoPago,
+// inclusive dos métodos que retornam listas. Útil para registrar em log o x-request-id de cada requisição, que é solicitado pelo suporte
+// do MercadoPago na abertura de chamados. Pode ser chamada a qualquer momento, inclusive com nil para remover a função configurada.
+func SetOnResponse(function func(ResponseMetadata)) {
+	onResponseMutex.Lock()
+	defer onResponseMutex.Unlock()
+	onResponse = function
+}
+
+// ResponseMetadata é a struct que contém os metadados da resposta HTTP do MercadoPago e o JSON original retornado,
+// permitindo ler campos que ainda não foram mapeados no SDK.
+type ResponseMetadata struct {
+	Method     string            // Método HTTP da requisição (exemplo: GET)
+	URL        string            // URL da requisição (sem os QueryParams)
+	StatusCode int               // Status HTTP da resposta
+	Headers    map[string]string // Headers da resposta (com os nomes no formato canônico, exemplo: X-Request-Id)
+	RequestID  string            // ID da requisição no MercadoPago (header x-request-id)
+	RawJSON    json.RawMessage   // JSON original retornado pelo MercadoPago
+}
+
+// Header é o método que retorna o valor de um header da resposta, sem diferenciar maiúsculas e minúsculas no nome do header.
+func (responseMetadata ResponseMetadata) Header(name string) string {
+	return responseMetadata.Headers[http.CanonicalHeaderKey(name)]
+}
+
+// Decode é o método que decoda o JSON original da resposta em qualquer struct ou map, útil para ler campos que ainda não foram mapeados no SDK.
+func (responseMetadata ResponseMetadata) Decode(v interface{}) error {
+	return json.Unmarshal(responseMetadata.RawJSON, v)
+}
+
+// responseMetadata é a função que monta os metadados da resposta de uma requisição e notifica a função configurada no SetOnResponse, caso ela tenha sido configurada.
+func responseMetadata(params request.Params, response *request.Response) ResponseMetadata {
+	headers := map[string]string{}
+	for name, value := range response.Headers {
+		if value, ok := value.(string); ok {
+			headers[http.CanonicalHeaderKey(name)] = value
+		}
+	}
+
+	url := params.URL
+	for _, pathParam := range params.PathParams {
+		url = strings.TrimSuffix(url, "/") + "/" + fmt.Sprint(pathParam)
+	}
+
+	metadata := ResponseMetadata{
+		Method:     params.Method,
+		URL:        url,
+		StatusCode: response.StatusCode,
+		Headers:    headers,
+		RequestID:  headers["X-Request-Id"],
+		RawJSON:    json.RawMessage(response.RawBody),
+	}
+
+	onResponseMutex.RLock()
+	function := onResponse
+	onResponseMutex.RUnlock()
+	if function != nil {
+		function(metadata)
+	}
+
+	return metadata
+}
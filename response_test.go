@@ -0,0 +1,74 @@
+package mercadopago
+
+import (
+	"testing"
+
+	"github.com/eduardo-mior/mercadopago-sdk-go/internal/request"
+)
+
+// Testando a montagem dos metadados da resposta e a preservação do JSON original nos erros
+func TestResponseMetadata(t *testing.T) {
+
+	var notified []ResponseMetadata
+	SetOnResponse(func(metadata ResponseMetadata) {
+		notified = append(notified, metadata)
+	})
+	defer SetOnResponse(nil)
+
+	params := request.Params{Method: "GET", URL: BASEURL + "/v1/payments", PathParams: request.PathParams{"1241420907"}}
+	response := &request.Response{
+		StatusCode: 404,
+		Headers:    request.Headers{"X-Request-Id": "7d3c1a2b-0000", "Content-Type": "application/json"},
+		RawBody:    []byte(`{"message": "Payment not found", "error": "not_found", "status": 404, "cause": [{"code": 2000, "description": "Payment not found"}], "new_field": true}`),
+	}
+
+	errResponse, err := parseError(responseMetadata(params, response))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	metadata := errResponse.Response
+	if metadata.StatusCode != 404 || metadata.RequestID != "7d3c1a2b-0000" || metadata.Header("content-type") != "application/json" {
+		t.Error("Metadados montados incorretamente!")
+		t.Error(metadata)
+	}
+
+	if metadata.URL != BASEURL+"/v1/payments/1241420907" || metadata.Method != "GET" {
+		t.Error("URL montada incorretamente: " + metadata.URL)
+	}
+
+	var raw struct {
+		NewField bool `json:"new_field"`
+	}
+	if err := metadata.Decode(&raw); err != nil || !raw.NewField {
+		t.Error("Campo não mapeado não encontrado no JSON original!")
+	}
+
+	if errResponse.Error != "not_found" || len(errResponse.Cause) != 1 || errResponse.Cause[0].Code != "2000" {
+		t.Error("Erro decodado incorretamente!")
+	}
+
+	if len(notified) != 1 || notified[0].RequestID != "7d3c1a2b-0000" {
+		t.Error("A função do SetOnResponse não foi chamada!")
+	}
+
+}
+
+// Testando os metadados da resposta dos métodos que retornam listas
+func TestListWithResponse(t *testing.T) {
+
+	paymentMethods, metadata, mercadopagoErr, err := GetPaymentMethodsWithResponse()
+	if err != nil || mercadopagoErr != nil {
+		t.Fatal("Erro ao buscar os métodos de pagamento!")
+	}
+
+	var raw []map[string]interface{}
+	if metadata.StatusCode != 200 || metadata.Method != "GET" || metadata.Decode(&raw) != nil || len(raw) != len(paymentMethods) || len(raw) == 0 {
+		t.Error("Metadados da lista de métodos de pagamento incorretos!")
+	}
+
+	if _, metadata, mercadopagoErr, err := ListReportsWithResponse("invalid_report"); err != nil || mercadopagoErr == nil || metadata.StatusCode != mercadopagoErr.Status {
+		t.Error("Metadados do erro da lista de relatórios incorretos!")
+	}
+
+}
@@ -0,0 +1,179 @@
+package mercadopago
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/eduardo-mior/mercadopago-sdk-go/identification"
+)
+
+// IDs dos sites (países) em que o MercadoPago opera
+const (
+	SiteArgentina = "MLA" // Argentina
+	SiteBrazil    = "MLB" // Brasil
+	SiteMexico    = "MLM" // México
+	SiteChile     = "MLC" // Chile
+	SiteColombia  = "MCO" // Colômbia
+	SitePeru      = "MPE" // Peru
+	SiteUruguay   = "MLU" // Uruguai
+)
+
+// ErrUnknownSite é o erro retornado pelo SetSite quando o site informado não é um dos sites do MercadoPago.
+var ErrUnknownSite = errors.New("mercadopago: site desconhecido, os sites disponíveis são MLA, MLB, MLM, MLC, MCO, MPE e MLU")
+
+var (
+	siteMutex sync.RWMutex
+
+	// configuredSiteID é o site configurado pelo SetSite, que tem prioridade sobre a variavel de ambiente MERCADO_PAGO_SITE_ID.
+	configuredSiteID string
+)
+
+// Site é a struct que contém as informações de um site (país) do MercadoPago, usadas para preencher os valores padrão dos pagamentos
+// (exemplo: a moeda dos itens) e para aplicar as regras de validação do país (exemplo: os tipos de documento aceitos).
+type Site struct {
+	ID                  string   // ID do site (exemplo: MLB)
+	Name                string   // Nome do país
+	CountryID           string   // Código do país no formato ISO-3166 (exemplo: BR)
+	CurrencyID          string   // Moeda do país no formato ISO-4217 (exemplo: BRL)
+	MinorUnits          int32    // Número de casas decimais aceitas na moeda do país (exemplo: BRL 2, CLP 0)
+	IdentificationTypes []string // Tipos de documento de identificação aceitos no país
+	PaymentTypes        []string // Tipos de métodos de pagamento disponíveis no país (exemplo: credit_card, ticket, bank_transfer)
+	TimeZone            string   // Fuso horário do país no formato IANA (exemplo: America/Sao_Paulo)
+	UTCOffset           int      // Diferença em horas do fuso horário para o UTC, sem considerar o horário de verão
+}
+
+// sites contém as informações de todos os sites do MercadoPago, na ordem retornada pela função Sites.
+var sites = []Site{
+	{SiteArgentina, "Argentina", "AR", "ARS", CurrencyMinorUnits("ARS"), []string{identification.DNI, identification.CUIT, identification.CUIL, identification.CI, "LE", "LC", "Otro"}, []string{"credit_card", "debit_card", "ticket", "account_money"}, "America/Argentina/Buenos_Aires", -3},
+	{SiteBrazil, "Brasil", "BR", "BRL", CurrencyMinorUnits("BRL"), []string{identification.CPF, identification.CNPJ}, []string{"credit_card", "debit_card", "ticket", "bank_transfer", "account_money"}, "America/Sao_Paulo", -3},
+	{SiteMexico, "México", "MX", "MXN", CurrencyMinorUnits("MXN"), []string{identification.RFC, identification.CURP}, []string{"credit_card", "debit_card", "ticket", "atm", "account_money"}, "America/Mexico_City", -6},
+	{SiteChile, "Chile", "CL", "CLP", CurrencyMinorUnits("CLP"), []string{identification.RUT, "Otro"}, []string{"credit_card", "debit_card", "prepaid_card", "account_money"}, "America/Santiago", -4},
+	{SiteColombia, "Colômbia", "CO", "COP", CurrencyMinorUnits("COP"), []string{identification.CC, "CE", identification.NIT, "Otro"}, []string{"credit_card", "debit_card", "ticket", "bank_transfer", "account_money"}, "America/Bogota", -5},
+	{SitePeru, "Peru", "PE", "PEN", CurrencyMinorUnits("PEN"), []string{identification.DNI, "CE", identification.RUC, "Otro"}, []string{"credit_card", "debit_card", "ticket", "atm", "account_money"}, "America/Lima", -5},
+	{SiteUruguay, "Uruguai", "UY", "UYU", CurrencyMinorUnits("UYU"), []string{identification.CI, "Otro"}, []string{"credit_card", "debit_card", "ticket", "account_money"}, "America/Montevideo", -3},
+}
+
+// Sites é a função que retorna as informações de todos os sites do MercadoPago.
+func Sites() []Site {
+	return append([]Site(nil), sites...)
+}
+
+// GetSite é a função que retorna as informações do site informado (exemplo: MLB), indicando se o site é conhecido pelo SDK.
+func GetSite(siteID string) (Site, bool) {
+	for _, site := range sites {
+		if strings.EqualFold(site.ID, siteID) {
+			return site, true
+		}
+	}
+	return Site{}, false
+}
+
+// SetSite é a função que configura o site (país) usado pelo SDK, com prioridade sobre a variavel de ambiente MERCADO_PAGO_SITE_ID.
+// Caso o site não seja um dos sites do MercadoPago então retorna ErrUnknownSite. Passando um site vazio a configuração é removida.
+// Para usar mais de um país no mesmo processo o site também pode ser informado em cada pagamento (campo SiteID).
+func SetSite(siteID string) error {
+	siteID = strings.TrimSpace(siteID)
+	if siteID != "" {
+		site, ok := GetSite(siteID)
+		if !ok {
+			return ErrUnknownSite
+		}
+		siteID = site.ID
+	}
+
+	siteMutex.Lock()
+	defer siteMutex.Unlock()
+	configuredSiteID = siteID
+	return nil
+}
+
+// CurrentSite é a função que retorna o site usado pelo SDK, configurado pelo SetSite ou pela variavel de ambiente MERCADO_PAGO_SITE_ID.
+// Caso nenhum site tenha sido configurado, ou o site não seja conhecido pelo SDK, então é retornado um Site somente com o ID (que pode ser vazio)
+// e nenhuma regra ou valor padrão do país é aplicado, deixando o MercadoPago decidir a moeda conforme a conta do access token.
+func CurrentSite() Site {
+	siteMutex.RLock()
+	siteID := configuredSiteID
+	siteMutex.RUnlock()
+
+	if siteID == "" {
+		siteID = os.Getenv("MERCADO_PAGO_SITE_ID")
+	}
+	return resolveSite(siteID)
+}
+
+// requestSite é a função que retorna o site informado em um pagamento (campo SiteID) ou, caso ele não tenha sido informado, o site configurado (CurrentSite).
+func requestSite(siteID string) Site {
+	if strings.TrimSpace(siteID) != "" {
+		return resolveSite(siteID)
+	}
+	return CurrentSite()
+}
+
+// resolveSite é a função que retorna as informações do site informado, ou um Site somente com o ID caso o site não seja conhecido pelo SDK.
+func resolveSite(siteID string) Site {
+	siteID = strings.TrimSpace(siteID)
+	if site, ok := GetSite(siteID); ok {
+		return site
+	}
+	return Site{ID: strings.ToUpper(siteID)}
+}
+
+// Known é o método que indica se o site é conhecido pelo SDK, ou seja, se possui as informações do país.
+func (site Site) Known() bool {
+	return site.CurrencyID != ""
+}
+
+// Location é o método que retorna o fuso horário do país. Caso a base de fusos horários não esteja disponível no sistema
+// então é retornado um fuso horário fixo com a diferença para o UTC (sem horário de verão).
+func (site Site) Location() *time.Location {
+	if location, err := time.LoadLocation(site.TimeZone); err == nil && site.TimeZone != "" {
+		return location
+	}
+	return time.FixedZone(site.TimeZone, site.UTCOffset*60*60)
+}
+
+// AcceptsIdentificationType é o método que indica se o tipo de documento de identificação é aceito no país.
+// Sites desconhecidos aceitam todos os tipos de documento.
+func (site Site) AcceptsIdentificationType(identificationType string) bool {
+	if !site.Known() {
+		return true
+	}
+	for _, accepted := range site.IdentificationTypes {
+		if strings.EqualFold(accepted, identificationType) {
+			return true
+		}
+	}
+	return false
+}
+
+// AcceptsPaymentType é o método que indica se o tipo de método de pagamento está disponível no país (exemplo: bank_transfer para o Pix no Brasil).
+// Sites desconhecidos aceitam todos os tipos de métodos de pagamento.
+func (site Site) AcceptsPaymentType(paymentType string) bool {
+	if !site.Known() {
+		return true
+	}
+	for _, accepted := range site.PaymentTypes {
+		if strings.EqualFold(accepted, paymentType) {
+			return true
+		}
+	}
+	return false
+}
+
+// withSiteCurrency é a função que retorna uma cópia dos itens com a moeda do site nos itens que não possuem moeda.
+func withSiteCurrency(items []Item, site Site) []Item {
+	if !site.Known() || len(items) == 0 {
+		return items
+	}
+	copied := make([]Item, len(items))
+	for i, item := range items {
+		if item.CurrencyID == "" {
+			item.CurrencyID = site.CurrencyID
+		}
+		copied[i] = item
+	}
+	return copied
+}
@@ -0,0 +1,161 @@
+package mercadopago
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+// setSite é a função que define o site usado pelo SDK durante o teste, restaurando o valor anterior no final do teste.
+func setSite(t *testing.T, siteID string) {
+	previous, exists := os.LookupEnv("MERCADO_PAGO_SITE_ID")
+	os.Setenv("MERCADO_PAGO_SITE_ID", siteID)
+	t.Cleanup(func() {
+		if exists {
+			os.Setenv("MERCADO_PAGO_SITE_ID", previous)
+		} else {
+			os.Unsetenv("MERCADO_PAGO_SITE_ID")
+		}
+	})
+}
+
+// Testando as informações dos sites e a configuração do site
+func TestSites(t *testing.T) {
+
+	if len(Sites()) != 7 {
+		t.Error("Quantidade de sites incorreta!")
+	}
+
+	site, ok := GetSite("mlc")
+	if !ok || site.CurrencyID != "CLP" || site.MinorUnits != 0 || !site.AcceptsIdentificationType("rut") || site.AcceptsIdentificationType("CPF") || site.Location() == nil {
+		t.Error("Informações do site MLC incorretas!")
+	}
+
+	if _, ok := GetSite("XXX"); ok {
+		t.Error("Site inexistente retornado!")
+	}
+
+	setSite(t, "")
+	if site := CurrentSite(); site.ID != "" || site.Known() || !site.AcceptsIdentificationType("DNI") {
+		t.Error("Sem site configurado nenhuma regra de país deve ser aplicada!")
+	}
+
+	setSite(t, "XXX")
+	if site := CurrentSite(); site.ID != "XXX" || site.Known() || !site.AcceptsIdentificationType("CPF") {
+		t.Error("Site desconhecido tratado incorretamente!")
+	}
+
+	if err := SetSite("XXX"); err != ErrUnknownSite {
+		t.Error("Era esperado erro de site desconhecido!")
+	}
+
+	t.Cleanup(func() { SetSite("") })
+	setSite(t, SiteArgentina)
+	if err := SetSite("mlc"); err != nil || CurrentSite().ID != SiteChile {
+		t.Error("O site do SetSite deve ter prioridade sobre a variavel de ambiente!")
+	}
+	if SetSite(""); CurrentSite().ID != SiteArgentina {
+		t.Error("Configuração do SetSite não removida!")
+	}
+
+}
+
+// Testando que nenhuma regra de país é aplicada quando o site não é configurado
+func TestValidateWithoutSite(t *testing.T) {
+
+	setSite(t, "")
+
+	paymentRequest := PaymentRequest{
+		Items: []Item{{Title: "Mensualidad", Quantity: 1, UnitPrice: MustParseMoney("10.50"), CurrencyID: "ARS"}},
+		Payer: Payer{Identification: PayerIdentification{Type: "DNI", Number: "12345678"}},
+	}
+	if err := paymentRequest.Validate(); err != nil {
+		t.Error("Moeda de outro país rejeitada sem site configurado: " + err.Error())
+	}
+	if err := paymentRequest.ValidatePayer(); err != nil {
+		t.Error("Documento de outro país rejeitado sem site configurado: " + err.Error())
+	}
+
+	items := []Item{{Title: "Mensalidade", Quantity: 1, UnitPrice: NewMoney(50)}}
+	if _, _, err := CreatePayment(PaymentRequest{Items: items}); err != nil {
+		t.Fatal(err)
+	}
+
+	lastRequest, _ := testServer.LastRequest()
+	var body struct {
+		Items []Item `json:"items"`
+	}
+	if err := json.Unmarshal(lastRequest.Body, &body); err != nil || len(body.Items) != 1 || body.Items[0].CurrencyID != "" {
+		t.Error("Moeda preenchida nos itens sem site configurado!")
+	}
+
+}
+
+// Testando as regras de validação do Chile (moeda sem centavos e RUT)
+func TestValidateForSite(t *testing.T) {
+
+	setSite(t, SiteChile)
+
+	paymentRequest := PaymentRequest{
+		Items: []Item{
+			{Title: "Mensualidad", Quantity: 1, UnitPrice: NewMoney(15000)},
+			{Title: "Matrícula", Quantity: 1, UnitPrice: NewMoney(9990.5)},
+			{Title: "Uniforme", Quantity: 1, UnitPrice: NewMoney(5000), CurrencyID: "BRL"},
+		},
+		Payer: Payer{Identification: PayerIdentification{Type: "CPF", Number: "191.191.191-00"}},
+	}
+
+	validationErrors, ok := paymentRequest.Validate().(ValidationErrors)
+	if !ok || len(validationErrors) != 2 || len(validationErrors.Field("items[1].unit_price")) != 1 || len(validationErrors.Field("items[2].currency_id")) != 1 {
+		t.Error("Erros de validação do site incorretos!")
+		t.Error(validationErrors)
+	}
+
+	if validationErrors, ok := paymentRequest.ValidatePayer().(ValidationErrors); !ok || validationErrors[0].Field != "payer.identification.type" {
+		t.Error("Documento de outro país aceito no site!")
+	}
+
+	paymentRequest.Payer.Identification = PayerIdentification{Type: "RUT", Number: "12.345.678-5"}
+	if err := paymentRequest.ValidatePayer(); err != nil {
+		t.Error("RUT válido retornou erro: " + err.Error())
+	}
+
+	pixPaymentRequest := PixPaymentRequest{TransactionAmount: NewMoney(10), Payer: PaymentPayer{Email: "comprador@email.com"}}
+	if validationErrors, ok := pixPaymentRequest.Validate().(ValidationErrors); !ok || len(validationErrors.Field("payment_method_id")) != 1 {
+		t.Error("Pix aceito fora do Brasil!")
+	}
+
+}
+
+// Testando o preenchimento da moeda dos itens com a moeda do site e o site informado no pagamento
+func TestCreatePaymentSiteCurrency(t *testing.T) {
+
+	setSite(t, SiteArgentina)
+
+	items := []Item{{Title: "Mensualidad", Quantity: 1, UnitPrice: NewMoney(50)}}
+	for siteID, currencyID := range map[string]string{"": "ARS", SiteMexico: "MXN"} {
+		if _, _, err := CreatePayment(PaymentRequest{Items: items, SiteID: siteID}); err != nil {
+			t.Fatal(err)
+		}
+
+		lastRequest, _ := testServer.LastRequest()
+		var body map[string]json.RawMessage
+		var sentItems []Item
+		if err := json.Unmarshal(lastRequest.Body, &body); err != nil || json.Unmarshal(body["items"], &sentItems) != nil || len(sentItems) != 1 || sentItems[0].CurrencyID != currencyID {
+			t.Error("Moeda do site não preenchida nos itens: " + currencyID)
+		}
+		if _, ok := body["SiteID"]; ok {
+			t.Error("O site do pagamento não deve ser enviado para o MercadoPago!")
+		}
+	}
+
+	if items[0].CurrencyID != "" {
+		t.Error("Os itens originais não devem ser alterados!")
+	}
+
+	pixPaymentRequest := PixPaymentRequest{TransactionAmount: NewMoney(10), Payer: PaymentPayer{Email: "comprador@email.com"}, SiteID: SiteBrazil}
+	if err := pixPaymentRequest.Validate(); err != nil {
+		t.Error("Pix com o site do Brasil no pagamento rejeitado: " + err.Error())
+	}
+
+}
@@ -0,0 +1,282 @@
+package mercadopago
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// PaymentStatus é o tipo que representa o status de um pagamento.
+// Valores desconhecidos retornados pelo MercadoPago são preservados, use o método IsKnown para identificar esses valores.
+type PaymentStatus string
+
+// Status de um pagamento (segundo documentação oficial do mercado pago)
+const (
+	StatusPending     PaymentStatus = "pending"      // Pagamento pendente
+	StatusApproved    PaymentStatus = "approved"     // Pagamento aprovado
+	StatusAuthorized  PaymentStatus = "authorized"   // Pagamento autorizado porém ainda não capturado
+	StatusInProcess   PaymentStatus = "in_process"   // O pagamento esta sendo revisado pelo MercadoPago
+	StatusInMediation PaymentStatus = "in_mediation" // Foi aberta uma disputa no pagamento e ele esta em revisão
+	StatusRejected    PaymentStatus = "rejected"     // Pagamento rejeitado (cartão de crédito)
+	StatusCancelled   PaymentStatus = "cancelled"    // O pagamento foi cancelado por uma das partes ou porque ele expirou
+	StatusRefunded    PaymentStatus = "refunded"     // O pagamento foi reembolsado para o usuário
+	StatusChargedBack PaymentStatus = "charged_back" // Foi feito um estorno do pagamento no cartão de crédito do usuário
+)
+
+// IsKnown é o método que indica se o status é um dos status documentados pelo MercadoPago.
+func (status PaymentStatus) IsKnown() bool {
+	switch status {
+	case StatusPending, StatusApproved, StatusAuthorized, StatusInProcess, StatusInMediation,
+		StatusRejected, StatusCancelled, StatusRefunded, StatusChargedBack:
+		return true
+	}
+	return false
+}
+
+// IsFinal é o método que indica se o processamento do pagamento foi finalizado, ou seja, o pagamento não esta mais aguardando o pagador ou o MercadoPago.
+// Mesmo um pagamento finalizado como aprovado ainda pode ser reembolsado, contestado ou estornado posteriormente.
+func (status PaymentStatus) IsFinal() bool {
+	switch status {
+	case StatusApproved, StatusRejected, StatusCancelled, StatusRefunded, StatusChargedBack:
+		return true
+	}
+	return false
+}
+
+// IsPending é o método que indica se o pagamento ainda esta aguardando o pagador ou o processamento do MercadoPago (pending, in_process, authorized).
+func (status PaymentStatus) IsPending() bool {
+	return status == StatusPending || status == StatusInProcess || status == StatusAuthorized
+}
+
+// IsApproved é o método que indica se o pagamento foi aprovado.
+func (status PaymentStatus) IsApproved() bool {
+	return status == StatusApproved
+}
+
+// IsRejected é o método que indica se o pagamento foi rejeitado.
+func (status PaymentStatus) IsRejected() bool {
+	return status == StatusRejected
+}
+
+// IsCancelled é o método que indica se o pagamento foi cancelado (ou expirou).
+func (status PaymentStatus) IsCancelled() bool {
+	return status == StatusCancelled
+}
+
+// IsReversed é o método que indica se o dinheiro de um pagamento aprovado foi devolvido ao pagador (refunded ou charged_back).
+func (status PaymentStatus) IsReversed() bool {
+	return status == StatusRefunded || status == StatusChargedBack
+}
+
+// UnmarshalJSON é o método que decoda o status de forma tolerante, aceitando qualquer valor (inclusive null e números) sem retornar erro.
+func (status *PaymentStatus) UnmarshalJSON(data []byte) error {
+	*status = PaymentStatus(unmarshalEnum(data))
+	return nil
+}
+
+///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// StatusDetail é o tipo que representa o detalhe do status de um pagamento, que explica o motivo do status (exemplo: cc_rejected_insufficient_amount).
+// A lista completa pode ser consultada em https://www.mercadopago.com.br/developers/pt/guides/online-payments/checkout-api/handling-responses
+type StatusDetail string
+
+// Detalhes do status de um pagamento
+const (
+	StatusDetailAccredited             StatusDetail = "accredited"               // Pagamento aprovado
+	StatusDetailPartiallyRefunded      StatusDetail = "partially_refunded"       // Pagamento aprovado com parte do valor reembolsado
+	StatusDetailPendingContingency     StatusDetail = "pending_contingency"      // O pagamento esta sendo processado
+	StatusDetailPendingReviewManual    StatusDetail = "pending_review_manual"    // O pagamento esta sendo revisado manualmente pelo MercadoPago
+	StatusDetailPendingWaitingPayment  StatusDetail = "pending_waiting_payment"  // Aguardando pagamento do boleto ou do PEC (lotérica)
+	StatusDetailPendingWaitingTransfer StatusDetail = "pending_waiting_transfer" // Aguardando pagamento do pix / aguardando transferência do dinheiro
+	StatusDetailPendingCapture         StatusDetail = "pending_capture"          // Pagamento autorizado aguardando captura
+	StatusDetailPendingChallenge       StatusDetail = "pending_challenge"        // Aguardando o desafio do 3DS ser concluído pelo pagador
+	StatusDetailOfflineProcess         StatusDetail = "offline_process"          // Pagamento sendo processado offline
+
+	StatusDetailCCRejectedBadFilledCardNumber   StatusDetail = "cc_rejected_bad_filled_card_number"   // Número do cartão preenchido incorretamente
+	StatusDetailCCRejectedBadFilledDate         StatusDetail = "cc_rejected_bad_filled_date"          // Data de expiração preenchida incorretamente
+	StatusDetailCCRejectedBadFilledOther        StatusDetail = "cc_rejected_bad_filled_other"         // Algum dado do cartão preenchido incorretamente
+	StatusDetailCCRejectedBadFilledSecurityCode StatusDetail = "cc_rejected_bad_filled_security_code" // Código de segurança preenchido incorretamente
+	StatusDetailCCRejectedBlacklist             StatusDetail = "cc_rejected_blacklist"                // Cartão de crédito recusado (lista de bloqueio)
+	StatusDetailCCRejectedCallForAuthorize      StatusDetail = "cc_rejected_call_for_authorize"       // O pagador deve autorizar o pagamento com o banco emissor
+	StatusDetailCCRejectedCardDisabled          StatusDetail = "cc_rejected_card_disabled"            // Cartão de crédito bloqueado
+	StatusDetailCCRejectedCardError             StatusDetail = "cc_rejected_card_error"               // Não foi possível processar o pagamento com o cartão
+	StatusDetailCCRejectedDuplicatedPayment     StatusDetail = "cc_rejected_duplicated_payment"       // Pagamento duplicado
+	StatusDetailCCRejectedHighRisk              StatusDetail = "cc_rejected_high_risk"                // Cartão de crédito recusado pela prevenção a fraudes
+	StatusDetailCCRejectedInsufficientAmount    StatusDetail = "cc_rejected_insufficient_amount"      // Saldo/limite insuficiente
+	StatusDetailCCRejectedInvalidInstallments   StatusDetail = "cc_rejected_invalid_installments"     // O cartão não aceita o número de parcelas escolhido
+	StatusDetailCCRejectedMaxAttempts           StatusDetail = "cc_rejected_max_attempts"             // Limite de tentativas com o cartão excedido
+	StatusDetailCCRejectedOtherReason           StatusDetail = "cc_rejected_other_reason"             // O banco emissor recusou o pagamento sem informar o motivo
+	StatusDetailCCRejectedCardTypeNotAllowed    StatusDetail = "cc_rejected_card_type_not_allowed"    // O tipo do cartão não é aceito
+	StatusDetailCCRejected3DSMandatory          StatusDetail = "cc_rejected_3ds_mandatory"            // O pagamento exige autenticação 3DS
+	StatusDetailCCRejected3DSChallenge          StatusDetail = "cc_rejected_3ds_challenge"            // O pagador não concluiu o desafio do 3DS
+	StatusDetailCCAmountRateLimitExceeded       StatusDetail = "cc_amount_rate_limit_exceeded"        // Limite de valor do meio de pagamento excedido
+	StatusDetailRejectedHighRisk                StatusDetail = "rejected_high_risk"                   // Pagamento recusado pela prevenção a fraudes (exemplo: paypal)
+	StatusDetailRejectedInsufficientData        StatusDetail = "rejected_insufficient_data"           // Pagamento recusado por falta de dados obrigatórios
+	StatusDetailRejectedByBank                  StatusDetail = "rejected_by_bank"                     // Pagamento recusado pelo banco
+	StatusDetailRejectedByRegulations           StatusDetail = "rejected_by_regulations"              // Pagamento recusado por regulamentações
+
+	StatusDetailByCollector StatusDetail = "by_collector" // Pagamento cancelado pelo vendedor
+	StatusDetailByPayer     StatusDetail = "by_payer"     // Pagamento cancelado pelo pagador
+	StatusDetailByAdmin     StatusDetail = "by_admin"     // Pagamento cancelado pelo MercadoPago
+	StatusDetailExpired     StatusDetail = "expired"      // Pagamento cancelado porque expirou (exemplo: boleto ou pix não pago)
+
+	StatusDetailRefunded    StatusDetail = "refunded"     // Pagamento reembolsado
+	StatusDetailBPPRefunded StatusDetail = "bpp_refunded" // Pagamento reembolsado pelo Programa de Proteção ao Comprador
+	StatusDetailSettled     StatusDetail = "settled"      // Estorno (chargeback) resolvido
+	StatusDetailReimbursed  StatusDetail = "reimbursed"   // Estorno (chargeback) reembolsado ao vendedor
+	StatusDetailInProcess   StatusDetail = "in_process"   // Estorno (chargeback) em análise
+)
+
+// knownStatusDetails contém todos os detalhes de status documentados.
+var knownStatusDetails = map[StatusDetail]bool{
+	StatusDetailAccredited: true, StatusDetailPartiallyRefunded: true, StatusDetailPendingContingency: true,
+	StatusDetailPendingReviewManual: true, StatusDetailPendingWaitingPayment: true, StatusDetailPendingWaitingTransfer: true,
+	StatusDetailPendingCapture: true, StatusDetailPendingChallenge: true, StatusDetailOfflineProcess: true,
+	StatusDetailCCRejectedBadFilledCardNumber: true, StatusDetailCCRejectedBadFilledDate: true, StatusDetailCCRejectedBadFilledOther: true,
+	StatusDetailCCRejectedBadFilledSecurityCode: true, StatusDetailCCRejectedBlacklist: true, StatusDetailCCRejectedCallForAuthorize: true,
+	StatusDetailCCRejectedCardDisabled: true, StatusDetailCCRejectedCardError: true, StatusDetailCCRejectedDuplicatedPayment: true,
+	StatusDetailCCRejectedHighRisk: true, StatusDetailCCRejectedInsufficientAmount: true, StatusDetailCCRejectedInvalidInstallments: true,
+	StatusDetailCCRejectedMaxAttempts: true, StatusDetailCCRejectedOtherReason: true, StatusDetailCCRejectedCardTypeNotAllowed: true,
+	StatusDetailCCRejected3DSMandatory: true, StatusDetailCCRejected3DSChallenge: true, StatusDetailCCAmountRateLimitExceeded: true,
+	StatusDetailRejectedHighRisk: true, StatusDetailRejectedInsufficientData: true, StatusDetailRejectedByBank: true,
+	StatusDetailRejectedByRegulations: true, StatusDetailByCollector: true, StatusDetailByPayer: true, StatusDetailByAdmin: true,
+	StatusDetailExpired: true, StatusDetailRefunded: true, StatusDetailBPPRefunded: true, StatusDetailSettled: true,
+	StatusDetailReimbursed: true, StatusDetailInProcess: true,
+}
+
+// IsKnown é o método que indica se o detalhe do status é um dos detalhes documentados pelo MercadoPago.
+func (statusDetail StatusDetail) IsKnown() bool {
+	return knownStatusDetails[statusDetail]
+}
+
+// IsRejectedByCard é o método que indica se o pagamento foi rejeitado por causa do cartão ou dos dados do cartão (dados preenchidos incorretamente,
+// saldo insuficiente, cartão bloqueado, recusa do banco emissor...). Nesses casos o pagador pode tentar novamente corrigindo os dados ou usando outro cartão.
+// As rejeições da prevenção a fraudes do MercadoPago (cc_rejected_high_risk e cc_rejected_blacklist) não são consideradas rejeições do cartão.
+func (statusDetail StatusDetail) IsRejectedByCard() bool {
+	switch statusDetail {
+	case StatusDetailCCRejectedHighRisk, StatusDetailCCRejectedBlacklist, StatusDetailCCRejectedDuplicatedPayment:
+		return false
+	}
+	return strings.HasPrefix(string(statusDetail), "cc_rejected_")
+}
+
+// IsBadFilled é o método que indica se o pagamento foi rejeitado porque algum dado do cartão foi preenchido incorretamente.
+func (statusDetail StatusDetail) IsBadFilled() bool {
+	return strings.HasPrefix(string(statusDetail), "cc_rejected_bad_filled_")
+}
+
+// IsHighRisk é o método que indica se o pagamento foi rejeitado pela prevenção a fraudes do MercadoPago.
+func (statusDetail StatusDetail) IsHighRisk() bool {
+	return statusDetail == StatusDetailCCRejectedHighRisk || statusDetail == StatusDetailRejectedHighRisk || statusDetail == StatusDetailCCRejectedBlacklist
+}
+
+// IsWaitingPayer é o método que indica se o pagamento esta aguardando uma ação do pagador (pagar o boleto, o pix ou concluir o desafio do 3DS).
+func (statusDetail StatusDetail) IsWaitingPayer() bool {
+	return statusDetail == StatusDetailPendingWaitingPayment || statusDetail == StatusDetailPendingWaitingTransfer || statusDetail == StatusDetailPendingChallenge
+}
+
+// UnmarshalJSON é o método que decoda o detalhe do status de forma tolerante, aceitando qualquer valor (inclusive null e números) sem retornar erro.
+func (statusDetail *StatusDetail) UnmarshalJSON(data []byte) error {
+	*statusDetail = StatusDetail(unmarshalEnum(data))
+	return nil
+}
+
+///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// PaymentTypeID é o tipo que representa o tipo do método de pagamento (exemplo: credit_card).
+type PaymentTypeID string
+
+// Tipos de métodos de pagamento
+const (
+	PaymentTypeAccountMoney    PaymentTypeID = "account_money"    // Saldo da conta do MercadoPago
+	PaymentTypeTicket          PaymentTypeID = "ticket"           // Boleto, PEC (lotérica) e outros pagamentos em dinheiro
+	PaymentTypeBankTransfer    PaymentTypeID = "bank_transfer"    // Pix e outras transferências bancárias
+	PaymentTypeATM             PaymentTypeID = "atm"              // Pagamento em caixa eletrônico
+	PaymentTypeCreditCard      PaymentTypeID = "credit_card"      // Cartão de crédito
+	PaymentTypeDebitCard       PaymentTypeID = "debit_card"       // Cartão de débito
+	PaymentTypePrepaidCard     PaymentTypeID = "prepaid_card"     // Cartão pré-pago
+	PaymentTypeDigitalCurrency PaymentTypeID = "digital_currency" // Linha de crédito do MercadoPago (Mercado Crédito)
+	PaymentTypeDigitalWallet   PaymentTypeID = "digital_wallet"   // Carteiras digitais (exemplo: paypal)
+	PaymentTypeVoucherCard     PaymentTypeID = "voucher_card"     // Cartões de benefício (vale alimentação/refeição)
+	PaymentTypeCryptoTransfer  PaymentTypeID = "crypto_transfer"  // Transferência de criptomoedas
+)
+
+// IsKnown é o método que indica se o tipo do método de pagamento é um dos tipos documentados pelo MercadoPago.
+func (paymentTypeID PaymentTypeID) IsKnown() bool {
+	switch paymentTypeID {
+	case PaymentTypeAccountMoney, PaymentTypeTicket, PaymentTypeBankTransfer, PaymentTypeATM, PaymentTypeCreditCard, PaymentTypeDebitCard,
+		PaymentTypePrepaidCard, PaymentTypeDigitalCurrency, PaymentTypeDigitalWallet, PaymentTypeVoucherCard, PaymentTypeCryptoTransfer:
+		return true
+	}
+	return false
+}
+
+// IsCard é o método que indica se o tipo do método de pagamento é um cartão (crédito, débito, pré-pago ou benefício).
+func (paymentTypeID PaymentTypeID) IsCard() bool {
+	switch paymentTypeID {
+	case PaymentTypeCreditCard, PaymentTypeDebitCard, PaymentTypePrepaidCard, PaymentTypeVoucherCard:
+		return true
+	}
+	return false
+}
+
+// IsOffline é o método que indica se o pagamento é feito fora do checkout e fica pendente até o pagador pagar (boleto, PEC, pix, caixa eletrônico).
+func (paymentTypeID PaymentTypeID) IsOffline() bool {
+	return paymentTypeID == PaymentTypeTicket || paymentTypeID == PaymentTypeBankTransfer || paymentTypeID == PaymentTypeATM
+}
+
+// UnmarshalJSON é o método que decoda o tipo do método de pagamento de forma tolerante, aceitando qualquer valor (inclusive null e números) sem retornar erro.
+func (paymentTypeID *PaymentTypeID) UnmarshalJSON(data []byte) error {
+	*paymentTypeID = PaymentTypeID(unmarshalEnum(data))
+	return nil
+}
+
+///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// OperationType é o tipo que representa o tipo da operação de um pagamento (exemplo: regular_payment).
+type OperationType string
+
+// Tipos de operação de um pagamento
+const (
+	OperationRegularPayment    OperationType = "regular_payment"    // Pagamento normal
+	OperationMoneyTransfer     OperationType = "money_transfer"     // Transferência de dinheiro entre usuários
+	OperationRecurringPayment  OperationType = "recurring_payment"  // Pagamento recorrente (assinaturas)
+	OperationAccountFund       OperationType = "account_fund"       // Depósito de dinheiro na conta do MercadoPago
+	OperationPaymentAddition   OperationType = "payment_addition"   // Adição de dinheiro a um pagamento existente
+	OperationCellphoneRecharge OperationType = "cellphone_recharge" // Recarga de celular
+	OperationPOSPayment        OperationType = "pos_payment"        // Pagamento feito em uma maquininha (ponto de venda)
+	OperationInvestment        OperationType = "investment"         // Investimento do dinheiro da conta
+)
+
+// IsKnown é o método que indica se o tipo da operação é um dos tipos documentados pelo MercadoPago.
+func (operationType OperationType) IsKnown() bool {
+	switch operationType {
+	case OperationRegularPayment, OperationMoneyTransfer, OperationRecurringPayment, OperationAccountFund,
+		OperationPaymentAddition, OperationCellphoneRecharge, OperationPOSPayment, OperationInvestment:
+		return true
+	}
+	return false
+}
+
+// UnmarshalJSON é o método que decoda o tipo da operação de forma tolerante, aceitando qualquer valor (inclusive null e números) sem retornar erro.
+func (operationType *OperationType) UnmarshalJSON(data []byte) error {
+	*operationType = OperationType(unmarshalEnum(data))
+	return nil
+}
+
+///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// unmarshalEnum é a função que converte qualquer valor JSON em string, strings são decodadas normalmente, null resulta em uma string vazia
+// e os demais valores (números, booleanos) são preservados como texto. Dessa forma um valor inesperado nunca faz o Unmarshal do pagamento inteiro falhar.
+func unmarshalEnum(data []byte) string {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return ""
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err == nil {
+		return value
+	}
+	return string(data)
+}
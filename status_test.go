@@ -0,0 +1,47 @@
+package mercadopago
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// Testando o Unmarshal tolerante dos status e os helpers de status
+func TestPaymentStatusEnums(t *testing.T) {
+
+	var payment PaymentConsultResponse
+	err := json.Unmarshal([]byte(`{"status": "rejected", "status_detail": "cc_rejected_bad_filled_security_code", "payment_type_id": "credit_card", "operation_type": 42}`), &payment)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !payment.Status.IsRejected() || !payment.Status.IsFinal() || payment.Status.IsPending() {
+		t.Error("Helpers do status rejected incorretos!")
+	}
+
+	if !payment.StatusDetail.IsRejectedByCard() || !payment.StatusDetail.IsBadFilled() || payment.StatusDetail != StatusDetailCCRejectedBadFilledSecurityCode {
+		t.Error("Helpers do status_detail incorretos!")
+	}
+
+	if !payment.PaymentTypeID.IsCard() || payment.OperationType != "42" || payment.OperationType.IsKnown() {
+		t.Error("Valores desconhecidos devem ser preservados!")
+		t.Error(payment.OperationType)
+	}
+
+	err = json.Unmarshal([]byte(`{"status": "future_status", "status_detail": null, "payment_type_id": "bank_transfer"}`), &payment)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if payment.Status != "future_status" || payment.Status.IsKnown() || payment.Status.IsFinal() || payment.StatusDetail != "" || !payment.PaymentTypeID.IsOffline() {
+		t.Error("Unmarshal tolerante incorreto!")
+	}
+
+	if StatusDetailCCRejectedHighRisk.IsRejectedByCard() || !StatusDetailCCRejectedHighRisk.IsHighRisk() {
+		t.Error("Rejeição da prevenção a fraudes não deve ser considerada rejeição do cartão!")
+	}
+
+	if !StatusDetailPendingWaitingTransfer.IsWaitingPayer() || !StatusApproved.IsFinal() || !StatusChargedBack.IsReversed() {
+		t.Error("Helpers de status incorretos!")
+	}
+
+}
@@ -0,0 +1,88 @@
+package mercadopago
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// TimeLayout é o formato de data usado pelo MercadoPago nas requisições (exemplo: 2022-01-25T12:30:00.000-04:00).
+const TimeLayout = "2006-01-02T15:04:05.000-07:00"
+
+// DefaultTimeZone é o fuso horário usado pelo MercadoPago quando a data é retornada sem fuso horário (GMT-4).
+var DefaultTimeZone = time.FixedZone("GMT-4", -4*60*60)
+
+// timeLayouts contém todos os formatos de data que já foram observados nos retornos do MercadoPago, em ordem de prioridade.
+var timeLayouts = []string{
+	time.RFC3339Nano,                     // 2022-01-25T12:30:00.123-04:00, 2022-01-25T12:30:00Z
+	"2006-01-02T15:04:05.999999999-0700", // 2022-01-25T12:30:00.123-0400
+	"2006-01-02T15:04:05.999999999",      // 2022-01-25T12:30:00.123 (sem fuso horário)
+	"2006-01-02 15:04:05.999999999",      // 2022-01-25 12:30:00 (sem fuso horário)
+	"2006-01-02",                         // 2022-01-25
+}
+
+// Time é o tipo de data usado em todos os models do SDK. Ele decoda de forma tolerante todos os formatos de data retornados pelo MercadoPago
+// (com ou sem milissegundos, com ou sem fuso horário, strings vazias e null) e encoda no formato esperado pelo MercadoPago (TimeLayout).
+// Uma data em um formato inválido nunca faz o Unmarshal do JSON inteiro falhar, ela resulta em uma data zerada e o valor original fica disponível no método Raw.
+type Time struct {
+	time.Time
+	raw string
+}
+
+// NewTime é a função que cria um *Time a partir de um time.Time, facilitando o preenchimento dos campos opcionais de data das requisições.
+func NewTime(t time.Time) *Time {
+	return &Time{Time: t}
+}
+
+// ParseTime é a função que converte uma data em qualquer um dos formatos retornados pelo MercadoPago.
+// Datas sem fuso horário são consideradas no fuso horário padrão do MercadoPago (DefaultTimeZone).
+func ParseTime(value string) (Time, error) {
+	var err error
+	for _, layout := range timeLayouts {
+		var parsed time.Time
+		if parsed, err = time.ParseInLocation(layout, value, DefaultTimeZone); err == nil {
+			return Time{Time: parsed}, nil
+		}
+	}
+	return Time{}, err
+}
+
+// Raw é o método que retorna o valor original que foi decodado, útil quando a data veio em um formato desconhecido.
+func (t Time) Raw() string {
+	return t.raw
+}
+
+// MarshalJSON é o método que encoda a data no formato esperado pelo MercadoPago (exemplo: 2022-01-25T12:30:00.000-04:00), datas zeradas são encodadas como null.
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.Format(TimeLayout))
+}
+
+// UnmarshalJSON é o método que decoda a data de forma tolerante. Valores null, strings vazias e datas em formatos desconhecidos resultam em uma data zerada sem erro.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	*t = Time{}
+
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		t.raw = string(data)
+		return nil
+	}
+
+	if value == "" {
+		return nil
+	}
+
+	parsed, err := ParseTime(value)
+	if err == nil {
+		*t = parsed
+	}
+	t.raw = value
+	return nil
+}
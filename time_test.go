@@ -0,0 +1,76 @@
+package mercadopago
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// Testando o Unmarshal tolerante de todos os formatos de data retornados pelo MercadoPago
+func TestTimeUnmarshal(t *testing.T) {
+
+	expected := time.Date(2022, 1, 25, 16, 30, 0, 0, time.UTC)
+
+	tests := map[string]time.Time{
+		`"2022-01-25T12:30:00.000-04:00"`: expected,
+		`"2022-01-25T12:30:00-04:00"`:     expected,
+		`"2022-01-25T16:30:00Z"`:          expected,
+		`"2022-01-25T16:30:00.000Z"`:      expected,
+		`"2022-01-25T12:30:00.000-0400"`:  expected,
+		`"2022-01-25T12:30:00.000"`:       expected,
+		`"2022-01-25T12:30:00"`:           expected,
+		`"2022-01-25"`:                    time.Date(2022, 1, 25, 4, 0, 0, 0, time.UTC),
+	}
+
+	for value, expectedTime := range tests {
+		var parsed Time
+		if err := json.Unmarshal([]byte(value), &parsed); err != nil {
+			t.Error(err)
+		} else if !parsed.Equal(expectedTime) {
+			t.Error("Data decodada incorretamente " + value + ": " + parsed.String())
+		}
+	}
+
+	var webhook WebhookResponse
+	err := json.Unmarshal([]byte(`{"id": 1, "date_created": "data inválida", "data": {"id": "1241420907"}}`), &webhook)
+	if err != nil {
+		t.Fatal("Uma data inválida não deve fazer o Unmarshal falhar!")
+	}
+
+	if !webhook.DateCreated.IsZero() || webhook.DateCreated.Raw() != "data inválida" || webhook.Data.ID != "1241420907" {
+		t.Error("Webhook decodado incorretamente!")
+	}
+
+	var payment PaymentConsultResponse
+	err = json.Unmarshal([]byte(`{"date_created": "", "date_approved": null, "date_last_updated": 12345}`), &payment)
+	if err != nil {
+		t.Fatal("Datas vazias, nulas ou inválidas não devem fazer o Unmarshal falhar!")
+	}
+
+	if !payment.DateCreated.IsZero() || payment.DateApproved != nil || payment.DateLastUpdated == nil || !payment.DateLastUpdated.IsZero() {
+		t.Error("Pagamento decodado incorretamente!")
+	}
+
+}
+
+// Testando o Marshal das datas no formato esperado pelo MercadoPago
+func TestTimeMarshal(t *testing.T) {
+
+	expiration := time.Date(2022, 1, 25, 12, 30, 0, 0, DefaultTimeZone)
+	data, err := json.Marshal(PaymentRequest{DateOfExpiration: NewTime(expiration)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var request map[string]json.RawMessage
+	json.Unmarshal(data, &request)
+
+	if string(request["date_of_expiration"]) != `"2022-01-25T12:30:00.000-04:00"` {
+		t.Error("Data encodada incorretamente: " + string(request["date_of_expiration"]))
+	}
+
+	if string(request["expiration_date_from"]) != "null" {
+		t.Error("Datas não informadas devem ser encodadas como null!")
+	}
+
+}
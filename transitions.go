@@ -0,0 +1,115 @@
+package mercadopago
+
+// paymentTransitions contém as transições válidas entre os status de um pagamento.
+// Um pagamento pendente pode ser aprovado, rejeitado ou cancelado, um pagamento aprovado pode ser reembolsado, contestado (in_mediation) ou estornado,
+// e uma contestação pode ser resolvida a favor do vendedor (volta para approved) ou do pagador (refunded ou charged_back).
+var paymentTransitions = map[PaymentStatus][]PaymentStatus{
+	StatusPending:     {StatusInProcess, StatusAuthorized, StatusApproved, StatusRejected, StatusCancelled},
+	StatusInProcess:   {StatusPending, StatusAuthorized, StatusApproved, StatusRejected, StatusCancelled},
+	StatusAuthorized:  {StatusApproved, StatusCancelled, StatusRejected},
+	StatusApproved:    {StatusRefunded, StatusInMediation, StatusChargedBack},
+	StatusInMediation: {StatusApproved, StatusRefunded, StatusChargedBack},
+	StatusChargedBack: {StatusApproved},
+	StatusRejected:    {},
+	StatusCancelled:   {},
+	StatusRefunded:    {},
+}
+
+// CanTransition é a função que indica se um pagamento pode passar do status from para o status to.
+// Permanecer no mesmo status sempre é válido, pois o detalhe do status (status_detail) pode mudar (exemplo: accredited para partially_refunded).
+func CanTransition(from, to PaymentStatus) bool {
+	if from == to {
+		return from.IsKnown()
+	}
+	for _, status := range paymentTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// NextStatuses é a função que retorna todos os status para os quais um pagamento pode passar a partir do status informado.
+func NextStatuses(from PaymentStatus) []PaymentStatus {
+	return append([]PaymentStatus(nil), paymentTransitions[from]...)
+}
+
+// UpdateAction é o tipo que indica oque deve ser feito com uma atualização de status de um pagamento.
+type UpdateAction int
+
+const (
+	// UpdateApply indica que a atualização é válida e deve ser aplicada.
+	UpdateApply UpdateAction = iota
+	// UpdateIgnore indica que a atualização esta desatualizada ou é repetida (exemplo: webhook recebido fora de ordem) e deve ser ignorada.
+	UpdateIgnore
+	// UpdateFlag indica que a atualização é uma transição impossível e deve ser analisada manualmente.
+	UpdateFlag
+)
+
+// String é o método que retorna o nome da ação (apply, ignore ou flag).
+func (updateAction UpdateAction) String() string {
+	switch updateAction {
+	case UpdateApply:
+		return "apply"
+	case UpdateIgnore:
+		return "ignore"
+	case UpdateFlag:
+		return "flag"
+	}
+	return "unknown"
+}
+
+// StatusUpdateDecision é a struct que contém a decisão sobre uma atualização de status e o motivo da decisão.
+type StatusUpdateDecision struct {
+	Action UpdateAction // Ação que deve ser tomada com a atualização
+	Reason string       // Motivo da decisão
+}
+
+// DecideStatusUpdate é a função que decide se uma nova versão de um pagamento (recebida do ConsultPayment após um webhook, por exemplo)
+// deve ser aplicada sobre a versão que temos armazenada, deve ser ignorada ou deve ser sinalizada para análise.
+//
+// A data da última atualização (DateLastUpdated) é usada como critério de desempate: uma versão mais antiga que a armazenada sempre é ignorada,
+// uma regressão de status (exemplo: approved para pending) sem data mais recente é tratada como webhook fora de ordem e ignorada, e uma regressão
+// com data mais recente, ou qualquer outra transição impossível, é sinalizada.
+func DecideStatusUpdate(stored, incoming PaymentConsultResponse) StatusUpdateDecision {
+	if stored.Status == "" {
+		return StatusUpdateDecision{UpdateApply, "nenhum status armazenado"}
+	}
+
+	if stored.ID != 0 && incoming.ID != 0 && stored.ID != incoming.ID {
+		return StatusUpdateDecision{UpdateFlag, "os pagamentos possuem IDs diferentes"}
+	}
+
+	if !incoming.Status.IsKnown() {
+		return StatusUpdateDecision{UpdateFlag, "status " + string(incoming.Status) + " desconhecido"}
+	}
+
+	// Comparando a data da última atualização quando as duas versões possuem data.
+	newer, older := false, false
+	if stored.DateLastUpdated != nil && incoming.DateLastUpdated != nil && !stored.DateLastUpdated.IsZero() && !incoming.DateLastUpdated.IsZero() {
+		newer = incoming.DateLastUpdated.After(stored.DateLastUpdated.Time)
+		older = incoming.DateLastUpdated.Before(stored.DateLastUpdated.Time)
+	}
+
+	if older {
+		return StatusUpdateDecision{UpdateIgnore, "a atualização é mais antiga que a versão armazenada"}
+	}
+
+	if stored.Status == incoming.Status {
+		if stored.StatusDetail != incoming.StatusDetail || newer {
+			return StatusUpdateDecision{UpdateApply, "atualização do pagamento sem mudança de status"}
+		}
+		return StatusUpdateDecision{UpdateIgnore, "atualização repetida"}
+	}
+
+	if CanTransition(stored.Status, incoming.Status) {
+		return StatusUpdateDecision{UpdateApply, "transição de " + string(stored.Status) + " para " + string(incoming.Status)}
+	}
+
+	// Caso a transição inversa seja válida a atualização provavelmente é uma versão antiga do pagamento recebida fora de ordem.
+	if CanTransition(incoming.Status, stored.Status) && !newer {
+		return StatusUpdateDecision{UpdateIgnore, "regressão de " + string(stored.Status) + " para " + string(incoming.Status) + " recebida fora de ordem"}
+	}
+
+	return StatusUpdateDecision{UpdateFlag, "transição impossível de " + string(stored.Status) + " para " + string(incoming.Status)}
+}
@@ -0,0 +1,75 @@
+package mercadopago
+
+import (
+	"testing"
+	"time"
+)
+
+// Testando as transições válidas entre os status de um pagamento
+func TestCanTransition(t *testing.T) {
+
+	valid := [][2]PaymentStatus{
+		{StatusPending, StatusApproved},
+		{StatusInProcess, StatusRejected},
+		{StatusAuthorized, StatusApproved},
+		{StatusApproved, StatusRefunded},
+		{StatusApproved, StatusInMediation},
+		{StatusInMediation, StatusChargedBack},
+		{StatusApproved, StatusApproved},
+	}
+	for _, transition := range valid {
+		if !CanTransition(transition[0], transition[1]) {
+			t.Errorf("A transição de %s para %s deveria ser válida!", transition[0], transition[1])
+		}
+	}
+
+	invalid := [][2]PaymentStatus{
+		{StatusApproved, StatusPending},
+		{StatusRejected, StatusApproved},
+		{StatusRefunded, StatusApproved},
+		{StatusCancelled, StatusPending},
+		{"unknown", "unknown"},
+	}
+	for _, transition := range invalid {
+		if CanTransition(transition[0], transition[1]) {
+			t.Errorf("A transição de %s para %s deveria ser inválida!", transition[0], transition[1])
+		}
+	}
+
+}
+
+// Testando a decisão sobre as atualizações de status recebidas fora de ordem
+func TestDecideStatusUpdate(t *testing.T) {
+
+	before := NewTime(time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC))
+	after := NewTime(before.Add(time.Minute))
+
+	payment := func(status PaymentStatus, statusDetail StatusDetail, lastUpdated *Time) PaymentConsultResponse {
+		return PaymentConsultResponse{ID: 1241420907, Status: status, StatusDetail: statusDetail, DateLastUpdated: lastUpdated}
+	}
+
+	tests := []struct {
+		name     string
+		stored   PaymentConsultResponse
+		incoming PaymentConsultResponse
+		expected UpdateAction
+	}{
+		{"primeira atualização", PaymentConsultResponse{}, payment(StatusPending, "", nil), UpdateApply},
+		{"aprovação", payment(StatusPending, "", before), payment(StatusApproved, "", after), UpdateApply},
+		{"webhook fora de ordem", payment(StatusApproved, "", after), payment(StatusPending, "", before), UpdateIgnore},
+		{"regressão sem data", payment(StatusApproved, "", nil), payment(StatusPending, "", nil), UpdateIgnore},
+		{"regressão mais recente", payment(StatusApproved, "", before), payment(StatusPending, "", after), UpdateFlag},
+		{"transição impossível", payment(StatusRejected, "", before), payment(StatusApproved, "", after), UpdateFlag},
+		{"reembolso parcial", payment(StatusApproved, StatusDetailAccredited, before), payment(StatusApproved, StatusDetailPartiallyRefunded, after), UpdateApply},
+		{"atualização repetida", payment(StatusApproved, StatusDetailAccredited, before), payment(StatusApproved, StatusDetailAccredited, before), UpdateIgnore},
+		{"status desconhecido", payment(StatusPending, "", nil), payment("future_status", "", nil), UpdateFlag},
+		{"pagamentos diferentes", payment(StatusPending, "", nil), PaymentConsultResponse{ID: 1, Status: StatusApproved}, UpdateFlag},
+	}
+
+	for _, test := range tests {
+		if decision := DecideStatusUpdate(test.stored, test.incoming); decision.Action != test.expected {
+			t.Errorf("%s: esperado %s, recebido %s (%s)", test.name, test.expected, decision.Action, decision.Reason)
+		}
+	}
+
+}
@@ -0,0 +1,11 @@
+package mercadopago
+
+// IsTestUser é o método que indica se a conta é um usuário de testes (tag test_user).
+func (user User) IsTestUser() bool {
+	for _, tag := range user.Tags {
+		if tag == "test_user" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+package mercadopago
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/eduardo-mior/mercadopago-sdk-go/mercadopagotest"
+)
+
+// Testando a criação de um usuário de testes
+func TestSuccessOnCreateTestUser(t *testing.T) {
+
+	testUser, mercadopagoErr, err := CreateTestUser("MLB", "comprador QA")
+
+	if err != nil {
+		t.Error("Erro inesperado!")
+		t.Error(err.Error())
+
+	} else if mercadopagoErr != nil {
+		t.Error("Erro não tratado MercadoPago!")
+		t.Error(mercadopagoErr.Message)
+
+	} else if testUser.ID == 0 || testUser.Nickname == "" || testUser.Password == "" || testUser.SiteID != "MLB" {
+		t.Error("Usuário de testes retornado incorretamente!")
+	}
+
+}
+
+// Testando a criação de um usuário de testes em um site inválido
+func TestErrorOnCreateTestUser(t *testing.T) {
+
+	testUser, mercadopagoErr, err := CreateTestUser("XXX", "comprador QA")
+	if err != nil || testUser != nil || mercadopagoErr == nil || mercadopagoErr.Status != 400 {
+		t.Error("Erro da criação do usuário de testes tratado incorretamente!")
+	}
+
+}
+
+// Testando a criação de um usuário de testes sem site informado e sem site configurado, que não deve chamar a API
+func TestErrorOnCreateTestUserWithoutSite(t *testing.T) {
+
+	server := mercadopagotest.NewServer()
+	defer server.Close()
+	defer server.Setenv()()
+
+	setSite(t, "")
+
+	for _, siteID := range []string{"", "   "} {
+		testUser, mercadopagoErr, err := CreateTestUser(siteID, "comprador QA")
+		if !errors.Is(err, ErrUnknownSite) || testUser != nil || mercadopagoErr != nil {
+			t.Error("Erro da criação do usuário de testes sem site tratado incorretamente!")
+		}
+	}
+
+	if len(server.Requests()) != 0 {
+		t.Error("Usuário de testes sem site enviado para a API!")
+	}
+
+}
+
+// Testando a consulta da conta dona do access token
+func TestSuccessOnGetMe(t *testing.T) {
+
+	user, mercadopagoErr, err := GetMe()
+	if err != nil || mercadopagoErr != nil {
+		t.Fatal("Erro ao consultar a conta!")
+	}
+
+	if user.ID != testServer.CollectorID || user.SiteID != "MLB" || !user.IsTestUser() || user.RegistrationDate.IsZero() {
+		t.Error("Conta retornada incorretamente!")
+	}
+
+}
@@ -0,0 +1,242 @@
+package mercadopago
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/eduardo-mior/mercadopago-sdk-go/identification"
+)
+
+// Códigos dos erros de validação retornados pelos validadores do SDK
+const (
+	ValidationRequired        = "required"         // Campo obrigatório não informado
+	ValidationInvalidLength   = "invalid_length"   // Campo com tamanho inválido
+	ValidationInvalidFormat   = "invalid_format"   // Campo com caracteres ou formato inválido
+	ValidationInvalidChecksum = "invalid_checksum" // Digito verificador inválido
+	ValidationInvalidValue    = "invalid_value"    // Campo com valor inválido
+	ValidationExpired         = "expired"          // Data expirada
+)
+
+// FieldError é a struct que contém as informações de um erro de validação de um campo.
+type FieldError struct {
+	Field   string // Caminho do campo que possui o erro (exemplo: card_number, items[0].title)
+	Code    string // Código do erro (exemplo: invalid_length)
+	Message string // Mensagem descritiva do erro
+}
+
+// Error é o método que retorna o erro de validação no formato "campo: mensagem".
+func (fieldError FieldError) Error() string {
+	return fieldError.Field + ": " + fieldError.Message
+}
+
+// ValidationErrors é a lista de todos os erros de validação encontrados em uma validação.
+type ValidationErrors []FieldError
+
+// Error é o método que retorna todos os erros de validação separados por ponto e vírgula.
+func (validationErrors ValidationErrors) Error() string {
+	messages := make([]string, len(validationErrors))
+	for i, fieldError := range validationErrors {
+		messages[i] = fieldError.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Field é o método que retorna os erros de validação de um determinado campo.
+func (validationErrors ValidationErrors) Field(field string) []FieldError {
+	var fieldErrors []FieldError
+	for _, fieldError := range validationErrors {
+		if fieldError.Field == field {
+			fieldErrors = append(fieldErrors, fieldError)
+		}
+	}
+	return fieldErrors
+}
+
+// add é o método que adiciona um novo erro de validação na lista.
+func (validationErrors *ValidationErrors) add(field, code, message string) {
+	*validationErrors = append(*validationErrors, FieldError{Field: field, Code: code, Message: message})
+}
+
+// err é o método que retorna a lista de erros como error, ou nil caso a lista esteja vazia.
+// Sempre devemos usar esse método para retornar a lista, evitando retornar um nil tipado que é diferente de nil.
+func (validationErrors ValidationErrors) err() error {
+	if len(validationErrors) == 0 {
+		return nil
+	}
+	return validationErrors
+}
+
+// MaxStatementDescriptorLength é o tamanho máximo da descrição que aparece no extrato do cartão (statement_descriptor).
+const MaxStatementDescriptorLength = 22
+
+// Validate é o método que valida localmente um pagamento antes de enviar para o MercadoPago, evitando uma requisição que seria rejeitada.
+// São validados os campos obrigatórios dos itens, quantidade e preço unitário positivos, a moeda igual em todos os itens, o auto_return
+// (que exige a URL de sucesso), o período de expiração, as URLs e o tamanho do statement_descriptor.
+// Quando o site do pagamento (SiteID ou o site configurado no CurrentSite) é conhecido a moeda dos itens deve ser a moeda do país e o preço unitário não pode possuir mais
+// casas decimais que a moeda aceita (exemplo: o peso chileno não aceita centavos).
+// Caso algum campo seja inválido então retorna um ValidationErrors com todos os erros encontrados e o caminho de cada campo (exemplo: items[0].title).
+func (paymentRequest PaymentRequest) Validate() error {
+	var validationErrors ValidationErrors
+
+	if len(paymentRequest.Items) == 0 {
+		validationErrors.add("items", ValidationRequired, "o pagamento deve possuir pelo menos um item")
+	}
+
+	site := requestSite(paymentRequest.SiteID)
+	currencyID := site.CurrencyID
+	for i, item := range paymentRequest.Items {
+		field := "items[" + strconv.Itoa(i) + "]"
+
+		if strings.TrimSpace(item.Title) == "" {
+			validationErrors.add(field+".title", ValidationRequired, "o título do item é obrigatório")
+		}
+		if item.Quantity <= 0 {
+			validationErrors.add(field+".quantity", ValidationInvalidValue, "a quantidade do item deve ser maior que zero")
+		}
+		if item.CurrencyID != "" {
+			if currencyID == "" {
+				currencyID = item.CurrencyID
+			} else if item.CurrencyID != currencyID && site.Known() {
+				validationErrors.add(field+".currency_id", ValidationInvalidValue, "a moeda dos itens deve ser a moeda do site "+site.ID+" ("+currencyID+")")
+			} else if item.CurrencyID != currencyID {
+				validationErrors.add(field+".currency_id", ValidationInvalidValue, "todos os itens devem possuir a mesma moeda ("+currencyID+")")
+			}
+		}
+
+		itemCurrencyID := item.CurrencyID
+		if itemCurrencyID == "" {
+			itemCurrencyID = currencyID
+		}
+		if !item.UnitPrice.IsPositive() {
+			validationErrors.add(field+".unit_price", ValidationInvalidValue, "o preço unitário do item deve ser maior que zero")
+		} else if itemCurrencyID != "" && !item.UnitPrice.Equal(item.UnitPrice.RoundCurrency(itemCurrencyID)) {
+			minorUnits := strconv.Itoa(int(CurrencyMinorUnits(itemCurrencyID)))
+			validationErrors.add(field+".unit_price", ValidationInvalidValue, "o preço unitário do item deve possuir no máximo "+minorUnits+" casas decimais ("+itemCurrencyID+")")
+		}
+		validationErrors.addURL(field+".picture_url", item.PictureURL)
+	}
+
+	switch paymentRequest.AutoReturn {
+	case "":
+	case "approved", "all":
+		if paymentRequest.BackUrls.Success == "" {
+			validationErrors.add("back_urls.success", ValidationRequired, "a URL de sucesso é obrigatória quando o auto_return é informado")
+		}
+	default:
+		validationErrors.add("auto_return", ValidationInvalidValue, "o auto_return deve ser approved ou all")
+	}
+
+	validationErrors.addURL("back_urls.success", paymentRequest.BackUrls.Success)
+	validationErrors.addURL("back_urls.pending", paymentRequest.BackUrls.Pending)
+	validationErrors.addURL("back_urls.failure", paymentRequest.BackUrls.Failure)
+	validationErrors.addURL("notification_url", paymentRequest.NotificationURL)
+
+	if paymentRequest.ExpirationDateFrom != nil && paymentRequest.ExpirationDateTo != nil && !paymentRequest.ExpirationDateFrom.Before(paymentRequest.ExpirationDateTo.Time) {
+		validationErrors.add("expiration_date_to", ValidationInvalidValue, "a data final de expiração deve ser posterior a data inicial")
+	}
+
+	if len([]rune(paymentRequest.StatementDescriptor)) > MaxStatementDescriptorLength {
+		validationErrors.add("statement_descriptor", ValidationInvalidLength, "o statement_descriptor deve possuir no máximo "+strconv.Itoa(MaxStatementDescriptorLength)+" caracteres")
+	}
+
+	return validationErrors.err()
+}
+
+// addURL é o método que valida se a URL é absoluta e usa o protocolo http ou https. URLs vazias não são validadas pois são opcionais.
+func (validationErrors *ValidationErrors) addURL(field, rawURL string) {
+	if rawURL == "" {
+		return
+	}
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+		validationErrors.add(field, ValidationInvalidFormat, "a URL "+rawURL+" é inválida")
+	}
+}
+
+// addRequired é o método que adiciona um erro de campo obrigatório caso o valor esteja vazio.
+func (validationErrors *ValidationErrors) addRequired(field, value, message string) {
+	if strings.TrimSpace(value) == "" {
+		validationErrors.add(field, ValidationRequired, message)
+	}
+}
+
+// addEmail é o método que valida se o email foi informado e se possui um formato válido.
+func (validationErrors *ValidationErrors) addEmail(field, email string) {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		validationErrors.add(field, ValidationRequired, "o email do pagador é obrigatório")
+	} else if at := strings.Index(email, "@"); at <= 0 || at == len(email)-1 {
+		validationErrors.add(field, ValidationInvalidFormat, "o email "+email+" é inválido")
+	}
+}
+
+// Normalize é o método que retorna o documento de identificação sem pontuação (pontos, traços, barras e espaços) e com o tipo em maiúsculo.
+func (payerIdentification PayerIdentification) Normalize() PayerIdentification {
+	return PayerIdentification{
+		Type:   strings.ToUpper(strings.TrimSpace(payerIdentification.Type)),
+		Number: identification.Normalize(payerIdentification.Number),
+	}
+}
+
+// ValidatePayer é o método que valida localmente o documento de identificação do pagador antes de enviar o pagamento para o MercadoPago.
+// A validação é opcional e caso o pagador não possua documento de identificação nenhum erro é retornado.
+// Caso sejam informados os tipos de documento retornados pelo GetIdentificationTypes então também é validado se o tipo é aceito e o tamanho mínimo e máximo do documento.
+// Caso contrario é validado se o tipo é aceito no site do pagamento (SiteID ou o site configurado no CurrentSite).
+// Os digitos verificadores são validados para todos os tipos de documento suportados pelo pacote identification, seguindo as regras do país.
+func (paymentRequest PaymentRequest) ValidatePayer(identificationTypes ...IdentificationType) error {
+	var validationErrors ValidationErrors
+	validationErrors.addPayerIdentification("payer.identification", paymentRequest.Payer.Identification, identificationTypes, requestSite(paymentRequest.SiteID))
+	return validationErrors.err()
+}
+
+// addPayerIdentification é o método que valida o documento de identificação do pagador adicionando os erros encontrados na lista.
+// Quando os tipos de documento não são informados é validado se o tipo é aceito no site.
+func (validationErrors *ValidationErrors) addPayerIdentification(field string, payerIdentification PayerIdentification, identificationTypes []IdentificationType, site Site) {
+	if payerIdentification.Type == "" && payerIdentification.Number == "" {
+		return
+	}
+
+	payerIdentification = payerIdentification.Normalize()
+	if payerIdentification.Type == "" {
+		validationErrors.add(field+".type", ValidationRequired, "o tipo do documento de identificação é obrigatório")
+		return
+	}
+	if payerIdentification.Number == "" {
+		validationErrors.add(field+".number", ValidationRequired, "o número do documento de identificação é obrigatório")
+		return
+	}
+
+	if len(identificationTypes) > 0 {
+		var identificationType *IdentificationType
+		for i := range identificationTypes {
+			if strings.EqualFold(identificationTypes[i].ID, payerIdentification.Type) {
+				identificationType = &identificationTypes[i]
+				break
+			}
+		}
+
+		if identificationType == nil {
+			validationErrors.add(field+".type", ValidationInvalidValue, "o tipo de documento "+payerIdentification.Type+" não é aceito")
+			return
+		}
+
+		length := len([]rune(payerIdentification.Number))
+		if (identificationType.MinLength > 0 && length < identificationType.MinLength) || (identificationType.MaxLength > 0 && length > identificationType.MaxLength) {
+			validationErrors.add(field+".number", ValidationInvalidLength, "o "+payerIdentification.Type+" deve possuir entre "+strconv.Itoa(identificationType.MinLength)+" e "+strconv.Itoa(identificationType.MaxLength)+" caracteres")
+			return
+		}
+	} else if !site.AcceptsIdentificationType(payerIdentification.Type) {
+		validationErrors.add(field+".type", ValidationInvalidValue, "o tipo de documento "+payerIdentification.Type+" não é aceito no site "+site.ID)
+		return
+	}
+
+	switch identification.ValidateForSite(site.ID, payerIdentification.Type, payerIdentification.Number) {
+	case identification.ErrInvalidLength:
+		validationErrors.add(field+".number", ValidationInvalidLength, "o tamanho do "+payerIdentification.Type+" é inválido")
+	case identification.ErrInvalidFormat:
+		validationErrors.add(field+".number", ValidationInvalidFormat, "o formato do "+payerIdentification.Type+" é inválido")
+	case identification.ErrInvalidCheckDigit:
+		validationErrors.add(field+".number", ValidationInvalidChecksum, "o digito verificador do "+payerIdentification.Type+" é inválido")
+	}
+}
@@ -0,0 +1,115 @@
+package mercadopago
+
+import (
+	"testing"
+	"time"
+)
+
+// Testando a validação opcional do documento de identificação do pagador
+func TestValidatePayer(t *testing.T) {
+
+	identificationTypes := []IdentificationType{
+		{ID: "CPF", Name: "CPF", Type: "number", MinLength: 11, MaxLength: 11},
+		{ID: "CNPJ", Name: "CNPJ", Type: "number", MinLength: 14, MaxLength: 14},
+	}
+
+	paymentRequest := PaymentRequest{Payer: Payer{Identification: PayerIdentification{Type: "cpf", Number: "123.456.789-09"}}}
+	if err := paymentRequest.ValidatePayer(identificationTypes...); err != nil {
+		t.Error("Erro inesperado!")
+		t.Error(err.Error())
+	}
+
+	if err := (PaymentRequest{}).ValidatePayer(identificationTypes...); err != nil {
+		t.Error("O documento de identificação do pagador é opcional!")
+	}
+
+	tests := []struct {
+		identification PayerIdentification
+		field          string
+		code           string
+	}{
+		{PayerIdentification{Type: "CPF", Number: "123.456.789-00"}, "payer.identification.number", ValidationInvalidChecksum},
+		{PayerIdentification{Type: "CNPJ", Number: "123.456.789-09"}, "payer.identification.number", ValidationInvalidLength},
+		{PayerIdentification{Type: "DNI", Number: "12345678"}, "payer.identification.type", ValidationInvalidValue},
+		{PayerIdentification{Number: "12345678909"}, "payer.identification.type", ValidationRequired},
+	}
+
+	for _, test := range tests {
+		err := PaymentRequest{Payer: Payer{Identification: test.identification}}.ValidatePayer(identificationTypes...)
+		validationErrors, ok := err.(ValidationErrors)
+		if !ok || len(validationErrors.Field(test.field)) != 1 || validationErrors.Field(test.field)[0].Code != test.code {
+			t.Error("Erro de validação incorreto para o documento " + test.identification.Type + " " + test.identification.Number)
+			t.Error(err)
+		}
+	}
+
+}
+
+// Testando a validação local de um pagamento antes de enviar para o MercadoPago
+func TestValidatePaymentRequest(t *testing.T) {
+
+	paymentRequest := PaymentRequest{
+		Items: []Item{
+			{Title: "Pagamendo mensalidade PagueTry", Quantity: 1, UnitPrice: NewMoney(50), CurrencyID: "BRL"},
+		},
+		AutoReturn:      "approved",
+		BackUrls:        BackUrls{Success: "https://localhost/sucesso"},
+		NotificationURL: "https://localhost/webhook/mercadopago",
+	}
+
+	if err := paymentRequest.Validate(); err != nil {
+		t.Error("Erro inesperado!")
+		t.Error(err.Error())
+	}
+
+	from := time.Now()
+	to := from.Add(-time.Hour)
+
+	paymentRequest = PaymentRequest{
+		Items: []Item{
+			{CurrencyID: "BRL"},
+			{Title: "Pagamendo semestralidade PagueTry", Quantity: 1, UnitPrice: NewMoney(300), CurrencyID: "ARS", PictureURL: "imagem.png"},
+		},
+		AutoReturn:          "approved",
+		BackUrls:            BackUrls{Pending: "localhost/pendente"},
+		NotificationURL:     "ftp://localhost/webhook",
+		ExpirationDateFrom:  NewTime(from),
+		ExpirationDateTo:    NewTime(to),
+		StatementDescriptor: "MENSALIDADE PAGUETRY ESCOLA",
+	}
+
+	err := paymentRequest.Validate()
+	validationErrors, ok := err.(ValidationErrors)
+	if !ok {
+		t.Fatal("Erros de validação não capturados!")
+	}
+
+	expected := map[string]string{
+		"items[0].title":       ValidationRequired,
+		"items[0].quantity":    ValidationInvalidValue,
+		"items[0].unit_price":  ValidationInvalidValue,
+		"items[1].currency_id": ValidationInvalidValue,
+		"items[1].picture_url": ValidationInvalidFormat,
+		"back_urls.success":    ValidationRequired,
+		"back_urls.pending":    ValidationInvalidFormat,
+		"notification_url":     ValidationInvalidFormat,
+		"expiration_date_to":   ValidationInvalidValue,
+		"statement_descriptor": ValidationInvalidLength,
+	}
+
+	if len(validationErrors) != len(expected) {
+		t.Error("Quantidade de erros de validação incorreta!")
+		t.Error(validationErrors.Error())
+	}
+
+	for field, code := range expected {
+		if fieldErrors := validationErrors.Field(field); len(fieldErrors) != 1 || fieldErrors[0].Code != code {
+			t.Error("Erro de validação incorreto para o campo " + field + "!")
+		}
+	}
+
+	if err := (PaymentRequest{}).Validate(); err == nil {
+		t.Error("Era esperado erro de validação para um pagamento sem itens!")
+	}
+
+}
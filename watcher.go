@@ -0,0 +1,367 @@
+package mercadopago
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Valores padrão do Watcher, usados quando os campos de configuração não são informados.
+const (
+	DefaultWatchMinInterval = 5 * time.Second // Intervalo inicial entre as consultas de um pagamento
+	DefaultWatchMaxInterval = 5 * time.Minute // Intervalo máximo entre as consultas de um pagamento
+	DefaultWatchBackoff     = 1.5             // Fator de aumento do intervalo quando o pagamento não muda ou a consulta falha
+	DefaultWatchRateLimit   = 5               // Número máximo de consultas por segundo somando todos os pagamentos
+	DefaultWatchMaxErrors   = 10              // Número máximo de erros seguidos antes de parar de acompanhar um pagamento
+)
+
+// ErrWatcherStarted é o erro retornado pelo Start quando o Watcher já foi iniciado.
+var ErrWatcherStarted = errors.New("mercadopago: o watcher já foi iniciado")
+
+// WatchEventType é o tipo que indica o motivo de um evento do Watcher.
+type WatchEventType int
+
+const (
+	// WatchChanged indica que o status ou o detalhe do status do pagamento mudou (ou que o pagamento foi consultado pela primeira vez).
+	WatchChanged WatchEventType = iota
+	// WatchFinal indica que o pagamento chegou em um status final (approved, rejected, cancelled, refunded, charged_back) e parou de ser acompanhado.
+	WatchFinal
+	// WatchExpired indica que a data de expiração (DateOfExpiration) do pagamento passou sem ele chegar em um status final e ele parou de ser acompanhado.
+	WatchExpired
+	// WatchError indica que a consulta do pagamento falhou. Após MaxErrors erros seguidos o pagamento para de ser acompanhado.
+	WatchError
+)
+
+// String é o método que retorna o nome do tipo do evento (changed, final, expired ou error).
+func (watchEventType WatchEventType) String() string {
+	switch watchEventType {
+	case WatchChanged:
+		return "changed"
+	case WatchFinal:
+		return "final"
+	case WatchExpired:
+		return "expired"
+	case WatchError:
+		return "error"
+	}
+	return "unknown"
+}
+
+// WatchEvent é a struct que contém as informações de um evento emitido pelo Watcher.
+type WatchEvent struct {
+	Type          WatchEventType          // Motivo do evento
+	PaymentID     string                  // ID do pagamento
+	Payment       *PaymentConsultResponse // Versão atual do pagamento (nil nos eventos de erro)
+	Previous      *PaymentConsultResponse // Versão anterior do pagamento (nil na primeira consulta)
+	Err           error                   // Erro da consulta (somente nos eventos de erro)
+	ErrorResponse *ErrorResponse          // Erro retornado pelo MercadoPago (somente nos eventos de erro)
+	Done          bool                    // Indica que o pagamento parou de ser acompanhado
+}
+
+// Watcher é a struct que acompanha o status de um conjunto de pagamentos consultando periodicamente o ConsultPayment, útil para Pix e boletos
+// quando não podemos depender somente dos webhooks. Os campos de configuração devem ser alterados antes do Start.
+//
+// Cada pagamento é consultado primeiro após MinInterval, e enquanto ele não muda o intervalo é multiplicado por Backoff até MaxInterval.
+// Quando o pagamento muda o intervalo volta para MinInterval. Erros também aumentam o intervalo e, quando o MercadoPago responde 429 (Too Many Requests),
+// todas as consultas são pausadas pelo tempo do header Retry-After. As consultas nunca passam de RateLimit por segundo somando todos os pagamentos.
+//
+// Os pagamentos podem ser adicionados e removidos a qualquer momento, de qualquer goroutine.
+type Watcher struct {
+	MinInterval time.Duration    // Intervalo inicial entre as consultas (padrão DefaultWatchMinInterval)
+	MaxInterval time.Duration    // Intervalo máximo entre as consultas (padrão DefaultWatchMaxInterval)
+	Backoff     float64          // Fator de aumento do intervalo (padrão DefaultWatchBackoff)
+	RateLimit   float64          // Consultas por segundo somando todos os pagamentos (padrão DefaultWatchRateLimit)
+	MaxErrors   int              // Erros seguidos antes de parar de acompanhar um pagamento (padrão DefaultWatchMaxErrors)
+	OnEvent     func(WatchEvent) // Função chamada a cada evento, quando informada os eventos não são enviados no canal Events
+
+	accessToken []string
+	events      chan WatchEvent
+	wake        chan struct{}
+	mutex       sync.Mutex
+	payments    map[string]*watchedPayment
+	cancel      context.CancelFunc
+	done        chan struct{}
+	pausedUntil time.Time
+	lastPoll    time.Time
+	now         func() time.Time // Relógio usado no agendamento das consultas e na verificação da expiração dos pagamentos (substituído nos testes)
+}
+
+// watchedPayment é a struct que contém o estado de um pagamento acompanhado pelo Watcher.
+type watchedPayment struct {
+	id       string
+	last     *PaymentConsultResponse
+	interval time.Duration
+	next     time.Time
+	errors   int
+}
+
+// NewWatcher é a função que cria um novo Watcher com as configurações padrão. O access token é opcional, assim como nos demais métodos do SDK.
+// O canal de eventos possui um buffer de 100 eventos e quando ele esta cheio as consultas aguardam até os eventos serem lidos.
+func NewWatcher(mercadoPagoAccessToken ...string) *Watcher {
+	return &Watcher{
+		MinInterval: DefaultWatchMinInterval,
+		MaxInterval: DefaultWatchMaxInterval,
+		Backoff:     DefaultWatchBackoff,
+		RateLimit:   DefaultWatchRateLimit,
+		MaxErrors:   DefaultWatchMaxErrors,
+		accessToken: mercadoPagoAccessToken,
+		events:      make(chan WatchEvent, 100),
+		wake:        make(chan struct{}, 1),
+		payments:    map[string]*watchedPayment{},
+		now:         time.Now,
+	}
+}
+
+// Events é o método que retorna o canal dos eventos do Watcher. O canal é fechado quando o Watcher é parado.
+func (watcher *Watcher) Events() <-chan WatchEvent {
+	return watcher.events
+}
+
+// Watch é o método que começa a acompanhar os pagamentos informados. Pagamentos que já estão sendo acompanhados são ignorados.
+func (watcher *Watcher) Watch(paymentIDs ...string) {
+	watcher.mutex.Lock()
+	for _, paymentID := range paymentIDs {
+		if _, ok := watcher.payments[paymentID]; !ok {
+			watcher.payments[paymentID] = &watchedPayment{id: paymentID, interval: watcher.MinInterval, next: watcher.now().Add(watcher.MinInterval)}
+		}
+	}
+	watcher.mutex.Unlock()
+	watcher.notify()
+}
+
+// Unwatch é o método que para de acompanhar os pagamentos informados, sem emitir eventos.
+func (watcher *Watcher) Unwatch(paymentIDs ...string) {
+	watcher.mutex.Lock()
+	for _, paymentID := range paymentIDs {
+		delete(watcher.payments, paymentID)
+	}
+	watcher.mutex.Unlock()
+	watcher.notify()
+}
+
+// Watching é o método que retorna a quantidade de pagamentos que estão sendo acompanhados.
+func (watcher *Watcher) Watching() int {
+	watcher.mutex.Lock()
+	defer watcher.mutex.Unlock()
+	return len(watcher.payments)
+}
+
+// Start é o método que inicia as consultas em uma nova goroutine. O Watcher para quando o contexto é cancelado ou quando o Stop é chamado.
+// Um Watcher só pode ser iniciado uma vez.
+func (watcher *Watcher) Start(ctx context.Context) error {
+	watcher.mutex.Lock()
+	defer watcher.mutex.Unlock()
+
+	if watcher.done != nil {
+		return ErrWatcherStarted
+	}
+
+	ctx, watcher.cancel = context.WithCancel(ctx)
+	watcher.done = make(chan struct{})
+	go watcher.run(ctx)
+	return nil
+}
+
+// Stop é o método que para o Watcher, aguardando a consulta em andamento terminar, e fecha o canal de eventos.
+func (watcher *Watcher) Stop() {
+	watcher.mutex.Lock()
+	cancel, done := watcher.cancel, watcher.done
+	watcher.mutex.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+// run é o método que executa as consultas, sempre consultando o pagamento com a próxima consulta mais próxima.
+func (watcher *Watcher) run(ctx context.Context) {
+	defer close(watcher.done)
+	defer close(watcher.events)
+
+	for {
+		payment, wait := watcher.nextPayment()
+
+		var timer *time.Timer
+		var fired <-chan time.Time
+		if payment != nil {
+			timer = time.NewTimer(wait)
+			fired = timer.C
+		}
+
+		select {
+		case <-ctx.Done():
+			stopTimer(timer)
+			return
+		case <-watcher.wake:
+			stopTimer(timer)
+			continue
+		case <-fired:
+		}
+
+		watcher.mutex.Lock()
+		current, ok := watcher.payments[payment.id]
+		watcher.lastPoll = watcher.now()
+		watcher.mutex.Unlock()
+		if !ok || current != payment {
+			continue
+		}
+
+		response, errorResponse, err := ConsultPayment(payment.id, watcher.accessToken...)
+		if event, ok := watcher.update(payment, response, errorResponse, err); ok && !watcher.emit(ctx, event) {
+			return
+		}
+	}
+}
+
+// nextPayment é o método que retorna o pagamento com a próxima consulta mais próxima e quanto tempo falta para ela,
+// respeitando o limite de consultas por segundo e a pausa causada por um erro 429.
+func (watcher *Watcher) nextPayment() (*watchedPayment, time.Duration) {
+	watcher.mutex.Lock()
+	defer watcher.mutex.Unlock()
+
+	var next *watchedPayment
+	for _, payment := range watcher.payments {
+		if next == nil || payment.next.Before(next.next) {
+			next = payment
+		}
+	}
+	if next == nil {
+		return nil, 0
+	}
+
+	at := next.next
+	if watcher.RateLimit > 0 {
+		if earliest := watcher.lastPoll.Add(time.Duration(float64(time.Second) / watcher.RateLimit)); earliest.After(at) {
+			at = earliest
+		}
+	}
+	if watcher.pausedUntil.After(at) {
+		at = watcher.pausedUntil
+	}
+	return next, at.Sub(watcher.now())
+}
+
+// update é o método que atualiza o estado do pagamento com o resultado da consulta, agenda a próxima consulta e retorna o evento que deve ser emitido.
+func (watcher *Watcher) update(payment *watchedPayment, response *PaymentConsultResponse, errorResponse *ErrorResponse, err error) (WatchEvent, bool) {
+	watcher.mutex.Lock()
+	defer watcher.mutex.Unlock()
+
+	// O pagamento pode ter sido removido durante a consulta
+	if watcher.payments[payment.id] != payment {
+		return WatchEvent{}, false
+	}
+
+	now := watcher.now()
+
+	if err != nil || errorResponse != nil {
+		payment.errors++
+		payment.interval = watcher.increase(payment.interval)
+		payment.next = now.Add(payment.interval)
+
+		if errorResponse != nil && errorResponse.Response.StatusCode == http.StatusTooManyRequests {
+			watcher.pausedUntil = now.Add(retryAfter(errorResponse.Response, payment.interval, now))
+		}
+
+		event := WatchEvent{Type: WatchError, PaymentID: payment.id, Previous: payment.last, Err: err, ErrorResponse: errorResponse}
+		if watcher.MaxErrors > 0 && payment.errors >= watcher.MaxErrors {
+			delete(watcher.payments, payment.id)
+			event.Done = true
+		}
+		return event, true
+	}
+
+	previous := payment.last
+	payment.last = response
+	payment.errors = 0
+	changed := previous == nil || previous.Status != response.Status || previous.StatusDetail != response.StatusDetail
+
+	switch {
+	case response.Status.IsFinal():
+		delete(watcher.payments, payment.id)
+		return WatchEvent{Type: WatchFinal, PaymentID: payment.id, Payment: response, Previous: previous, Done: true}, true
+
+	case response.DateOfExpiration != nil && !response.DateOfExpiration.IsZero() && now.After(response.DateOfExpiration.Time):
+		delete(watcher.payments, payment.id)
+		return WatchEvent{Type: WatchExpired, PaymentID: payment.id, Payment: response, Previous: previous, Done: true}, true
+	}
+
+	if changed {
+		payment.interval = watcher.MinInterval
+	} else {
+		payment.interval = watcher.increase(payment.interval)
+	}
+	payment.next = now.Add(payment.interval)
+
+	// Garantindo uma consulta logo após a expiração, para o pagamento não ficar sendo acompanhado além do necessário
+	if response.DateOfExpiration != nil && !response.DateOfExpiration.IsZero() && payment.next.After(response.DateOfExpiration.Time) {
+		payment.next = response.DateOfExpiration.Time.Add(time.Millisecond)
+	}
+
+	if !changed {
+		return WatchEvent{}, false
+	}
+	return WatchEvent{Type: WatchChanged, PaymentID: payment.id, Payment: response, Previous: previous}, true
+}
+
+// increase é o método que aumenta o intervalo pelo fator de Backoff, sem passar do intervalo máximo.
+func (watcher *Watcher) increase(interval time.Duration) time.Duration {
+	backoff := watcher.Backoff
+	if backoff < 1 {
+		backoff = 1
+	}
+	interval = time.Duration(float64(interval) * backoff)
+	if watcher.MaxInterval > 0 && interval > watcher.MaxInterval {
+		interval = watcher.MaxInterval
+	}
+	if interval < watcher.MinInterval {
+		interval = watcher.MinInterval
+	}
+	return interval
+}
+
+// emit é o método que entrega o evento para a função OnEvent ou para o canal de eventos. Retorna false quando o Watcher foi parado enquanto aguardava.
+func (watcher *Watcher) emit(ctx context.Context, event WatchEvent) bool {
+	if watcher.OnEvent != nil {
+		watcher.OnEvent(event)
+		return true
+	}
+
+	select {
+	case watcher.events <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// notify é o método que acorda a goroutine das consultas para ela recalcular a próxima consulta.
+func (watcher *Watcher) notify() {
+	select {
+	case watcher.wake <- struct{}{}:
+	default:
+	}
+}
+
+// stopTimer é a função que para o timer da próxima consulta, caso ele exista.
+func stopTimer(timer *time.Timer) {
+	if timer != nil {
+		timer.Stop()
+	}
+}
+
+// retryAfter é a função que retorna o tempo de espera do header Retry-After (em segundos ou em data HTTP, comparada com o now),
+// ou o valor padrão quando o header não foi retornado.
+func retryAfter(metadata ResponseMetadata, fallback time.Duration, now time.Time) time.Duration {
+	value := metadata.Header("Retry-After")
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return date.Sub(now)
+	}
+	return fallback
+}
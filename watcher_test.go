@@ -0,0 +1,217 @@
+package mercadopago
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/eduardo-mior/mercadopago-sdk-go/mercadopagotest"
+)
+
+// newTestWatcher é a função auxiliar dos testes que cria um Watcher com intervalos curtos.
+func newTestWatcher() *Watcher {
+	watcher := NewWatcher()
+	watcher.MinInterval = 10 * time.Millisecond
+	watcher.MaxInterval = 40 * time.Millisecond
+	watcher.RateLimit = 200
+	return watcher
+}
+
+// nextEvent é a função auxiliar dos testes que aguarda o próximo evento do Watcher.
+func nextEvent(t *testing.T, watcher *Watcher) WatchEvent {
+	t.Helper()
+	select {
+	case event := <-watcher.Events():
+		return event
+	case <-time.After(2 * time.Second):
+		t.Fatal("Nenhum evento recebido!")
+	}
+	return WatchEvent{}
+}
+
+// Testando acompanhamento de uma cobrança Pix até a aprovação
+func TestWatcherFinal(t *testing.T) {
+
+	pixCharge, mercadopagoErr, err := CreatePixPayment(PixPaymentRequest{TransactionAmount: NewMoney(10), Payer: PaymentPayer{Email: "raniellimontagna@hotmail.com"}})
+	if err != nil || mercadopagoErr != nil {
+		t.Fatal("Erro inesperado!", err, mercadopagoErr)
+	}
+	paymentID := strconv.Itoa(pixCharge.ID)
+
+	watcher := newTestWatcher()
+	watcher.Watch(paymentID)
+	watcher.Start(context.Background())
+	defer watcher.Stop()
+
+	if err := watcher.Start(context.Background()); err != ErrWatcherStarted {
+		t.Error("Watcher iniciado duas vezes!")
+	}
+
+	event := nextEvent(t, watcher)
+	if event.Type != WatchChanged || event.Previous != nil || event.Payment.Status != StatusPending || event.Done {
+		t.Error("Primeiro evento incorreto!", event.Type, event.Payment)
+	}
+
+	testServer.ApprovePayment(int64(pixCharge.ID))
+
+	event = nextEvent(t, watcher)
+	if event.Type != WatchFinal || event.Previous.Status != StatusPending || event.Payment.Status != StatusApproved || !event.Done {
+		t.Error("Evento de status final incorreto!", event.Type, event.Payment)
+	}
+	if watcher.Watching() != 0 {
+		t.Error("Pagamento finalizado continua sendo acompanhado!")
+	}
+
+}
+
+// Testando acompanhamento de um pagamento até a data de expiração
+func TestWatcherExpired(t *testing.T) {
+
+	id, _ := testServer.AddPayment(map[string]interface{}{
+		"transaction_amount": 10,
+		"payment_method_id":  "bolbradesco",
+		"status":             "pending",
+		"status_detail":      "pending_waiting_payment",
+		"date_of_expiration": time.Now().Add(time.Hour).Format(mercadopagotest.TimeLayout),
+	})
+
+	// O relógio do Watcher é adiantado depois do primeiro evento, assim o teste não depende do tempo real da primeira consulta
+	var offset int64
+	watcher := newTestWatcher()
+	watcher.now = func() time.Time { return time.Now().Add(time.Duration(atomic.LoadInt64(&offset))) }
+	watcher.Watch(strconv.FormatInt(id, 10))
+	watcher.Start(context.Background())
+	defer watcher.Stop()
+
+	if event := nextEvent(t, watcher); event.Type != WatchChanged {
+		t.Error("Primeiro evento incorreto!", event.Type)
+	}
+
+	atomic.StoreInt64(&offset, int64(2*time.Hour))
+	if event := nextEvent(t, watcher); event.Type != WatchExpired || !event.Done || event.Payment.Status != StatusPending {
+		t.Error("Evento de expiração incorreto!", event.Type)
+	}
+
+}
+
+// Testando eventos de erro, pausa por limite de requisições (429) e o limite de erros seguidos
+func TestWatcherErrors(t *testing.T) {
+
+	id, _ := testServer.AddPayment(map[string]interface{}{"transaction_amount": 10, "payment_method_id": "pix", "status": "pending"})
+	paymentID := strconv.FormatInt(id, 10)
+	testServer.FailNext("GET", "/v1/payments/"+paymentID, http.StatusTooManyRequests)
+
+	watcher := newTestWatcher()
+	watcher.MaxErrors = 2
+	watcher.Watch(paymentID, "999999")
+	watcher.Start(context.Background())
+	defer watcher.Stop()
+
+	var tooManyRequests, notFound, changed, done int
+	for tooManyRequests+changed < 2 || done == 0 {
+		event := nextEvent(t, watcher)
+		switch {
+		case event.Type == WatchError && event.ErrorResponse != nil && event.ErrorResponse.Status == http.StatusTooManyRequests:
+			tooManyRequests++
+		case event.Type == WatchError && event.PaymentID == "999999":
+			notFound++
+			if event.Done {
+				done++
+			}
+		case event.Type == WatchChanged && event.PaymentID == paymentID:
+			changed++
+		}
+	}
+
+	if tooManyRequests != 1 || changed != 1 || notFound != 2 {
+		t.Error("Eventos de erro incorretos!", tooManyRequests, changed, notFound)
+	}
+	if watcher.Watching() != 1 {
+		t.Error("Pagamento inexistente continua sendo acompanhado!")
+	}
+
+}
+
+// Testando o aumento do intervalo quando o pagamento não muda e a volta para o intervalo mínimo quando ele muda
+func TestWatcherBackoff(t *testing.T) {
+
+	watcher := NewWatcher()
+	watcher.MinInterval = time.Second
+	watcher.MaxInterval = 3 * time.Second
+	watcher.Backoff = 2
+
+	payment := &watchedPayment{id: "1", interval: time.Second}
+	watcher.payments["1"] = payment
+	pending := &PaymentConsultResponse{Status: StatusPending}
+
+	watcher.update(payment, pending, nil, nil)
+	if _, ok := watcher.update(payment, pending, nil, nil); ok || payment.interval != 2*time.Second {
+		t.Error("Intervalo não aumentado!", payment.interval)
+	}
+	watcher.update(payment, pending, nil, nil)
+	if payment.interval != 3*time.Second {
+		t.Error("Intervalo passou do máximo!", payment.interval)
+	}
+	if _, ok := watcher.update(payment, &PaymentConsultResponse{Status: StatusInProcess}, nil, nil); !ok || payment.interval != time.Second {
+		t.Error("Intervalo não voltou para o mínimo!", payment.interval)
+	}
+
+	if retryAfter(ResponseMetadata{Headers: map[string]string{"Retry-After": "7"}}, time.Second, time.Now()) != 7*time.Second {
+		t.Error("Header Retry-After não respeitado!")
+	}
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	if retryAfter(ResponseMetadata{Headers: map[string]string{"Retry-After": "Mon, 19 Oct 2026 12:00:30 GMT"}}, time.Second, now) != 30*time.Second {
+		t.Error("Header Retry-After em data HTTP não respeitado!")
+	}
+
+}
+
+// Testando registro concorrente de pagamentos e entrega dos eventos pela função OnEvent
+func TestWatcherConcurrentWatch(t *testing.T) {
+
+	var ids []string
+	for i := 0; i < 10; i++ {
+		id, _ := testServer.AddPayment(map[string]interface{}{"transaction_amount": 10, "payment_method_id": "master"})
+		ids = append(ids, strconv.FormatInt(id, 10))
+	}
+
+	var mutex sync.Mutex
+	finished := map[string]bool{}
+	allFinished := make(chan struct{})
+
+	watcher := newTestWatcher()
+	watcher.OnEvent = func(event WatchEvent) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if event.Type == WatchFinal {
+			finished[event.PaymentID] = true
+			if len(finished) == len(ids) {
+				close(allFinished)
+			}
+		}
+	}
+	watcher.Start(context.Background())
+	defer watcher.Stop()
+
+	var wg sync.WaitGroup
+	for _, id := range ids {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			watcher.Watch(id, id)
+		}(id)
+	}
+	wg.Wait()
+
+	select {
+	case <-allFinished:
+	case <-time.After(3 * time.Second):
+		t.Fatal("Nem todos os pagamentos foram finalizados!")
+	}
+
+}